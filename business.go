@@ -0,0 +1,178 @@
+package timex
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// HolidayCalendar reports whether a date is a holiday. Business days are
+// the days from Monday through Friday that are not holidays.
+type HolidayCalendar interface {
+	IsHoliday(t time.Time) bool
+}
+
+// HolidayFunc adapts an ordinary function to a HolidayCalendar.
+type HolidayFunc func(t time.Time) bool
+
+// IsHoliday returns f(t).
+func (f HolidayFunc) IsHoliday(t time.Time) bool {
+	return f(t)
+}
+
+// Holidays is a HolidayCalendar made of a list of dates. Only the dates
+// are compared; the clocks and locations are ignored.
+type Holidays []time.Time
+
+// IsHoliday returns whether the date of `t` is in the list.
+func (h Holidays) IsHoliday(t time.Time) bool {
+	for _, d := range h {
+		if daysBetweenDates(d, t) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HolidayCalendars combines calendars. A date is a holiday when it is a
+// holiday in any of them.
+type HolidayCalendars []HolidayCalendar
+
+// IsHoliday returns whether `t` is a holiday in any of the calendars.
+func (hc HolidayCalendars) IsHoliday(t time.Time) bool {
+	for _, c := range hc {
+		if c.IsHoliday(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// BusinessDayConvention is the rule used to move a date that falls on a
+// non-business day to a business day.
+type BusinessDayConvention int
+
+// The ISDA business day conventions.
+const (
+	// Unadjusted leaves the date alone.
+	Unadjusted BusinessDayConvention = iota
+	// Following moves to the next business day.
+	Following
+	// ModifiedFollowing moves to the next business day unless that is in
+	// the next month, in which case it moves to the previous business day.
+	ModifiedFollowing
+	// Preceding moves to the previous business day.
+	Preceding
+	// ModifiedPreceding moves to the previous business day unless that is
+	// in the previous month, in which case it moves to the next business
+	// day.
+	ModifiedPreceding
+	// MonthEnd moves to the last business day of the month, whether or
+	// not the date is a business day.
+	MonthEnd
+)
+
+var businessDayConventionNames = [...]string{
+	Unadjusted:        "Unadjusted",
+	Following:         "Following",
+	ModifiedFollowing: "ModifiedFollowing",
+	Preceding:         "Preceding",
+	ModifiedPreceding: "ModifiedPreceding",
+	MonthEnd:          "MonthEnd",
+}
+
+func (c BusinessDayConvention) String() string {
+	if c < 0 || int(c) >= len(businessDayConventionNames) {
+		return "BusinessDayConvention(" + strconv.Itoa(int(c)) + ")"
+	}
+	return businessDayConventionNames[c]
+}
+
+// Adjust returns a new time.Time moved to a business day according to
+// convention `c`. A nil calendar has no holidays. The clock of the time
+// is not adjusted. Like NextBusinessDay, it panics if there is no
+// business day to move to.
+func Adjust(t time.Time, c BusinessDayConvention, cal HolidayCalendar) time.Time {
+	switch c {
+	case Following:
+		return adjustFollowing(t, cal)
+	case ModifiedFollowing:
+		nt := adjustFollowing(t, cal)
+		if daysBetweenDates(LastDayOfMonth(t), nt) > 0 {
+			nt = adjustPreceding(t, cal)
+		}
+		return nt
+	case Preceding:
+		return adjustPreceding(t, cal)
+	case ModifiedPreceding:
+		nt := adjustPreceding(t, cal)
+		if daysBetweenDates(FirstDayOfMonth(t), nt) < 0 {
+			nt = adjustFollowing(t, cal)
+		}
+		return nt
+	case MonthEnd:
+		return adjustPreceding(LastDayOfMonth(t), cal)
+	}
+	return t
+}
+
+// IsBusinessDay returns whether `t` falls on a business day: Monday
+// through Friday and not a holiday in `cal`. A nil calendar has no
+// holidays.
+func IsBusinessDay(t time.Time, cal HolidayCalendar) bool {
+	switch t.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(t)
+}
+
+// maxBusinessDaySearch is the number of days NextBusinessDay and
+// PrevBusinessDay look through before giving up, about three years.
+const maxBusinessDaySearch = 3 * 366
+
+// NextBusinessDay returns a new time.Time for the first business day
+// after `t`. It is NextBusinessWeekday for dates that also skips the
+// holidays in `cal`. The clock of the time is not adjusted.
+//
+// It panics if `cal` has no business day in the three years after `t`.
+func NextBusinessDay(t time.Time, cal HolidayCalendar) time.Time {
+	nt := NextDayOfWeek(t, NextBusinessWeekday(t.Weekday()), true)
+	for !IsBusinessDay(nt, cal) {
+		if daysBetweenDates(t, nt) >= maxBusinessDaySearch {
+			panic(fmt.Sprintf("timex: no business day in the three years after %s", t.Format("2006-01-02")))
+		}
+		nt = NextDayOfWeek(nt, NextBusinessWeekday(nt.Weekday()), true)
+	}
+	return nt
+}
+
+// PrevBusinessDay returns a new time.Time for the last business day
+// before `t`. It is PrevBusinessWeekday for dates that also skips the
+// holidays in `cal`. The clock of the time is not adjusted.
+//
+// It panics if `cal` has no business day in the three years before `t`.
+func PrevBusinessDay(t time.Time, cal HolidayCalendar) time.Time {
+	pt := PrevDayOfWeek(t, PrevBusinessWeekday(t.Weekday()), true)
+	for !IsBusinessDay(pt, cal) {
+		if daysBetweenDates(pt, t) >= maxBusinessDaySearch {
+			panic(fmt.Sprintf("timex: no business day in the three years before %s", t.Format("2006-01-02")))
+		}
+		pt = PrevDayOfWeek(pt, PrevBusinessWeekday(pt.Weekday()), true)
+	}
+	return pt
+}
+
+func adjustFollowing(t time.Time, cal HolidayCalendar) time.Time {
+	if IsBusinessDay(t, cal) {
+		return t
+	}
+	return NextBusinessDay(t, cal)
+}
+
+func adjustPreceding(t time.Time, cal HolidayCalendar) time.Time {
+	if IsBusinessDay(t, cal) {
+		return t
+	}
+	return PrevBusinessDay(t, cal)
+}
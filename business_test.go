@@ -0,0 +1,218 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+// a few 2015 US holidays
+var holidays2015 = Holidays{
+	date(2015, time.January, 1),   // New Year's Day, Thursday
+	date(2015, time.May, 25),      // Memorial Day, Monday
+	date(2015, time.July, 3),      // Independence Day observed, Friday
+	date(2015, time.December, 25), // Christmas, Friday
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		cal      HolidayCalendar
+		expected bool
+	}{
+		{time.Date(2015, time.July, 2, 9, 0, 0, 0, nyc), holidays2015, true},
+		{time.Date(2015, time.July, 3, 9, 0, 0, 0, nyc), holidays2015, false},
+		{time.Date(2015, time.July, 3, 9, 0, 0, 0, nyc), nil, true},
+		{time.Date(2015, time.July, 4, 9, 0, 0, 0, nyc), nil, false},
+		{time.Date(2015, time.July, 5, 9, 0, 0, 0, nyc), nil, false},
+		{
+			time.Date(2015, time.July, 6, 9, 0, 0, 0, nyc),
+			HolidayFunc(func(t time.Time) bool { return t.Day() == 6 }),
+			false,
+		},
+		{
+			time.Date(2015, time.December, 25, 9, 0, 0, 0, nyc),
+			HolidayCalendars{Holidays{}, holidays2015},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		got := IsBusinessDay(c.t, c.cal)
+		if got != c.expected {
+			t.Errorf("IsBusinessDay(%v) == %t, want %t", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	cases := []struct {
+		t, expected time.Time
+	}{
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.July, 2, 15, 41, 0, 0, nyc),
+		},
+		// skips the holiday and the weekend
+		{
+			time.Date(2015, time.July, 2, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.July, 6, 15, 41, 0, 0, nyc),
+		},
+		// skips the weekend and the holiday
+		{
+			time.Date(2015, time.May, 22, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.May, 26, 15, 41, 0, 0, nyc),
+		},
+		{
+			time.Date(2014, time.December, 31, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.January, 2, 15, 41, 0, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := NextBusinessDay(c.t, holidays2015)
+		if got != c.expected {
+			t.Errorf("NextBusinessDay(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestPrevBusinessDay(t *testing.T) {
+	cases := []struct {
+		t, expected time.Time
+	}{
+		{
+			time.Date(2015, time.July, 2, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, nyc),
+		},
+		{
+			time.Date(2015, time.July, 6, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.July, 2, 15, 41, 0, 0, nyc),
+		},
+		{
+			time.Date(2015, time.May, 26, 15, 41, 0, 0, nyc),
+			time.Date(2015, time.May, 22, 15, 41, 0, 0, nyc),
+		},
+		{
+			time.Date(2015, time.January, 2, 15, 41, 0, 0, nyc),
+			time.Date(2014, time.December, 31, 15, 41, 0, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := PrevBusinessDay(c.t, holidays2015)
+		if got != c.expected {
+			t.Errorf("PrevBusinessDay(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestNoBusinessDays(t *testing.T) {
+	closed := HolidayFunc(func(time.Time) bool { return true })
+	d := date(2015, time.July, 1)
+
+	panics := func(f func()) (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		f()
+		return false
+	}
+
+	if !panics(func() { NextBusinessDay(d, closed) }) {
+		t.Errorf("NextBusinessDay(%v, closed) should panic", d)
+	}
+	if !panics(func() { PrevBusinessDay(d, closed) }) {
+		t.Errorf("PrevBusinessDay(%v, closed) should panic", d)
+	}
+	for _, c := range []BusinessDayConvention{Following, ModifiedFollowing, Preceding, ModifiedPreceding, MonthEnd} {
+		c := c
+		if !panics(func() { Adjust(d, c, closed) }) {
+			t.Errorf("Adjust(%v, %s, closed) should panic", d, c)
+		}
+	}
+	if !panics(func() { BusinessDaysAfter(2, closed)(d) }) {
+		t.Errorf("BusinessDaysAfter(2, closed)(%v) should panic", d)
+	}
+	if !panics(func() { BusinessDaysBefore(2, closed)(d) }) {
+		t.Errorf("BusinessDaysBefore(2, closed)(%v) should panic", d)
+	}
+
+	// nothing is searched for when the date is not moved
+	if got := Adjust(d, Unadjusted, closed); got != d {
+		t.Errorf("Adjust(%v, Unadjusted, closed) == %v, want %v", d, got, d)
+	}
+	if got := BusinessDaysAfter(0, closed)(d); got != d {
+		t.Errorf("BusinessDaysAfter(0, closed)(%v) == %v, want %v", d, got, d)
+	}
+
+	// a business day a year away is still found
+	reopens := date(2016, time.July, 1)
+	sparse := HolidayFunc(func(t time.Time) bool { return !t.Equal(reopens) })
+	if got := NextBusinessDay(d, sparse); got != reopens {
+		t.Errorf("NextBusinessDay(%v, sparse) == %v, want %v", d, got, reopens)
+	}
+	if got := PrevBusinessDay(date(2017, time.July, 1), sparse); got != reopens {
+		t.Errorf("PrevBusinessDay(%v, sparse) == %v, want %v", date(2017, time.July, 1), got, reopens)
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	cases := []struct {
+		t          time.Time
+		convention BusinessDayConvention
+		expected   time.Time
+	}{
+		// business days are never moved
+		{date(2015, time.July, 2), Following, date(2015, time.July, 2)},
+		{date(2015, time.July, 2), ModifiedFollowing, date(2015, time.July, 2)},
+		{date(2015, time.July, 2), Preceding, date(2015, time.July, 2)},
+		{date(2015, time.July, 2), ModifiedPreceding, date(2015, time.July, 2)},
+
+		// Saturday
+		{date(2015, time.July, 4), Unadjusted, date(2015, time.July, 4)},
+		{date(2015, time.July, 4), Following, date(2015, time.July, 6)},
+		{date(2015, time.July, 4), Preceding, date(2015, time.July, 2)},
+
+		// Saturday, October 31st; following crosses into November
+		{date(2015, time.October, 31), Following, date(2015, time.November, 2)},
+		{date(2015, time.October, 31), ModifiedFollowing, date(2015, time.October, 30)},
+
+		// Sunday, February 1st; preceding crosses into January
+		{date(2015, time.February, 1), Preceding, date(2015, time.January, 30)},
+		{date(2015, time.February, 1), ModifiedPreceding, date(2015, time.February, 2)},
+
+		// Thursday, January 1st is a holiday
+		{date(2015, time.January, 1), ModifiedPreceding, date(2015, time.January, 2)},
+
+		// the last business day of the month
+		{date(2015, time.October, 5), MonthEnd, date(2015, time.October, 30)},
+		{date(2015, time.December, 1), MonthEnd, date(2015, time.December, 31)},
+		{date(2015, time.July, 1), MonthEnd, date(2015, time.July, 31)},
+	}
+
+	for _, c := range cases {
+		got := Adjust(c.t, c.convention, holidays2015)
+		if got != c.expected {
+			t.Errorf("Adjust(%v, %s) == %v, want %v", c.t, c.convention, got, c.expected)
+		}
+	}
+}
+
+func TestBusinessDayConventionString(t *testing.T) {
+	cases := []struct {
+		c        BusinessDayConvention
+		expected string
+	}{
+		{Following, "Following"},
+		{ModifiedFollowing, "ModifiedFollowing"},
+		{MonthEnd, "MonthEnd"},
+		{BusinessDayConvention(42), "BusinessDayConvention(42)"},
+	}
+
+	for _, c := range cases {
+		got := c.c.String()
+		if got != c.expected {
+			t.Errorf("String() == %q, want %q", got, c.expected)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package timex
+
+import "time"
+
+// Calendar is a system of numbering days by year, month and day, such as
+// the Gregorian calendar of time.Time or the Julian calendar. The
+// adjusters in this package ending in "In" work with any Calendar.
+//
+// Months are numbered from 1 in the order they come in the year, up to
+// MonthsInYear. They are given as time.Month, though only in the
+// Gregorian and Julian calendars do they match its names; in calendars
+// with leap months a month's number may differ from year to year.
+type Calendar interface {
+	// Date returns the year, month and day of the civil date of `t`.
+	Date(t time.Time) (year int, month time.Month, day int)
+	// Time returns a new time.Time at midnight in `loc` for a date. The
+	// adjusters only pass dates that exist; the calendars of this
+	// package normalize others like time.Date.
+	Time(year int, month time.Month, day int, loc *time.Location) time.Time
+	// IsLeapYear returns whether the year is longer than a common year.
+	IsLeapYear(year int) bool
+	// MonthsInYear returns the number of months in the year.
+	MonthsInYear(year int) int
+	// DaysInMonth returns the number of days in the month for the year.
+	DaysInMonth(year int, month time.Month) int
+}
+
+// Gregorian is the proleptic Gregorian calendar of time.Time.
+type Gregorian struct{}
+
+// Date returns the year, month and day of `t`.
+func (Gregorian) Date(t time.Time) (year int, month time.Month, day int) {
+	return t.Date()
+}
+
+// Time returns a new time.Time at midnight in `loc` for a date. Like
+// time.Date, out of range months and days are normalized.
+func (Gregorian) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// IsLeapYear returns whether the year is a leap year: every fourth
+// year, except for centuries not divisible by 400.
+func (Gregorian) IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// MonthsInYear returns 12.
+func (Gregorian) MonthsInYear(year int) int {
+	return 12
+}
+
+// DaysInMonth returns the number of days in the month for the year.
+func (c Gregorian) DaysInMonth(y int, m time.Month) int {
+	if m == time.February && c.IsLeapYear(y) {
+		return 29
+	}
+	return daysInMonth[m]
+}
+
+func (Gregorian) String() string { return "Gregorian" }
+
+// Julian is the proleptic Julian calendar. Years are astronomical, so 1
+// BC is year 0.
+type Julian struct{}
+
+// Date returns the year, month and day of the civil date of `t`.
+func (Julian) Date(t time.Time) (year int, month time.Month, day int) {
+	return ToJulianCalendar(t)
+}
+
+// Time returns a new time.Time at midnight in `loc` for a date. Like
+// time.Date, out of range months and days are normalized.
+func (Julian) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return FromJulianCalendar(year, month, day, loc)
+}
+
+// IsLeapYear returns whether the year is a leap year, every fourth year.
+func (Julian) IsLeapYear(year int) bool {
+	return IsJulianLeapYear(year)
+}
+
+// MonthsInYear returns 12.
+func (Julian) MonthsInYear(year int) int {
+	return 12
+}
+
+// DaysInMonth returns the number of days in the month for the year.
+func (Julian) DaysInMonth(y int, m time.Month) int {
+	return DaysInJulianMonth(y, m)
+}
+
+func (Julian) String() string { return "Julian" }
+
+// NextMonthIn returns the year and month after `month` of `year` in
+// `c`. It will wrap from the last month of the year to the first of the
+// next.
+func NextMonthIn(c Calendar, year int, month time.Month) (int, time.Month) {
+	if int(month) >= c.MonthsInYear(year) {
+		return year + 1, 1
+	}
+	return year, month + 1
+}
+
+// PrevMonthIn returns the year and month before `month` of `year` in
+// `c`. It will wrap from the first month of the year to the last of the
+// previous.
+func PrevMonthIn(c Calendar, year int, month time.Month) (int, time.Month) {
+	if month <= 1 {
+		return year - 1, time.Month(c.MonthsInYear(year - 1))
+	}
+	return year, month - 1
+}
+
+// FirstDayOfMonthIn returns a new time.Time for the first day of the
+// month of `t` in `c`. The clock of the time is not adjusted.
+func FirstDayOfMonthIn(t time.Time, c Calendar) time.Time {
+	y, m, _ := c.Date(t)
+	return onDate(t, c.Time(y, m, 1, time.UTC))
+}
+
+// FirstDayOfNextMonthIn returns a new time.Time for the first day of the
+// month after that of `t` in `c`. The clock of the time is not adjusted.
+func FirstDayOfNextMonthIn(t time.Time, c Calendar) time.Time {
+	y, m, _ := c.Date(t)
+	y, m = NextMonthIn(c, y, m)
+	return onDate(t, c.Time(y, m, 1, time.UTC))
+}
+
+// LastDayOfMonthIn returns a new time.Time for the last day of the
+// month of `t` in `c`. The clock of the time is not adjusted.
+func LastDayOfMonthIn(t time.Time, c Calendar) time.Time {
+	y, m, _ := c.Date(t)
+	return onDate(t, lastDayIn(c, y, m))
+}
+
+// FirstDayOfYearIn returns a new time.Time for the first day of the year
+// of `t` in `c`. The clock of the time is not adjusted.
+func FirstDayOfYearIn(t time.Time, c Calendar) time.Time {
+	y, _, _ := c.Date(t)
+	return onDate(t, c.Time(y, 1, 1, time.UTC))
+}
+
+// LastDayOfYearIn returns a new time.Time for the last day of the year
+// of `t` in `c`. The clock of the time is not adjusted.
+func LastDayOfYearIn(t time.Time, c Calendar) time.Time {
+	y, _, _ := c.Date(t)
+	return onDate(t, lastDayIn(c, y, time.Month(c.MonthsInYear(y))))
+}
+
+// lastDayIn returns the last day of `month` of `year` in `c` at midnight
+// UTC. It counts the days from the first of the month, as in the month
+// of a Cutover the last day is not numbered DaysInMonth, and it does not
+// look at the next year, which a calendar's table may not have.
+func lastDayIn(c Calendar, year int, month time.Month) time.Time {
+	return c.Time(year, month, 1, time.UTC).AddDate(0, 0, c.DaysInMonth(year, month)-1)
+}
+
+// onDate returns `t` moved to the civil date of `date` without adjusting
+// its clock.
+func onDate(t, date time.Time) time.Time {
+	y, m, d := date.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, m, d, h, mi, s, t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,139 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+	"github.com/justrudd/timex/chinese"
+	"github.com/justrudd/timex/hebrew"
+	"github.com/justrudd/timex/hijri"
+	"github.com/justrudd/timex/persian"
+	"github.com/justrudd/timex/saka"
+)
+
+func TestGregorian(t *testing.T) {
+	for year := 1895; year <= 2105; year++ {
+		if got, expected := (Gregorian{}).IsLeapYear(year), year%4 == 0 && year != 1900 && year != 2100; got != expected {
+			t.Errorf("Gregorian.IsLeapYear(%v) == %v, want %v", year, got, expected)
+		}
+		for m := time.January; m <= time.December; m++ {
+			expected := time.Date(year, m+1, 0, 0, 0, 0, 0, utc).Day()
+			if got := (Gregorian{}).DaysInMonth(year, m); got != expected {
+				t.Errorf("Gregorian.DaysInMonth(%v, %v) == %v, want %v", year, m, got, expected)
+			}
+		}
+	}
+}
+
+func TestMonthIn(t *testing.T) {
+	cases := []struct {
+		c                  Calendar
+		year               int
+		month              time.Month
+		nextYear, prevYear int
+		next, prev         time.Month
+	}{
+		{Gregorian{}, 2015, time.January, 2015, 2014, time.February, time.December},
+		{Gregorian{}, 2015, time.December, 2016, 2015, time.January, time.November},
+		{Julian{}, 1582, time.June, 1582, 1582, time.July, time.May},
+	}
+
+	for _, c := range cases {
+		y, m := NextMonthIn(c.c, c.year, c.month)
+		if y != c.nextYear || m != c.next {
+			t.Errorf("NextMonthIn(%v, %v, %v) == %v, %v, want %v, %v", c.c, c.year, c.month, y, m, c.nextYear, c.next)
+		}
+		y, m = PrevMonthIn(c.c, c.year, c.month)
+		if y != c.prevYear || m != c.prev {
+			t.Errorf("PrevMonthIn(%v, %v, %v) == %v, %v, want %v, %v", c.c, c.year, c.month, y, m, c.prevYear, c.prev)
+		}
+	}
+}
+
+func TestAdjustersIn(t *testing.T) {
+	cases := []struct {
+		c                                      Calendar
+		t                                      time.Time
+		firstOfMonth, firstOfNext, lastOfMonth time.Time
+		firstOfYear, lastOfYear                time.Time
+	}{
+		{Gregorian{}, date(2016, time.February, 10),
+			date(2016, time.February, 1), date(2016, time.March, 1), date(2016, time.February, 29),
+			date(2016, time.January, 1), date(2016, time.December, 31)},
+		{Julian{}, date(1900, time.March, 1),
+			date(1900, time.February, 13), date(1900, time.March, 14), date(1900, time.March, 13),
+			date(1900, time.January, 13), date(1901, time.January, 13)},
+		{CutoverRome, date(1582, time.October, 20),
+			date(1582, time.October, 11), date(1582, time.November, 1), date(1582, time.October, 31),
+			date(1582, time.January, 11), date(1582, time.December, 31)},
+		{chinese.Calendar, date(2023, time.April, 1),
+			date(2023, time.March, 22), date(2023, time.April, 20), date(2023, time.April, 19),
+			date(2023, time.January, 22), date(2024, time.February, 9)},
+		{hebrew.Calendar, date(2016, time.March, 20),
+			date(2016, time.March, 11), date(2016, time.April, 9), date(2016, time.April, 8),
+			date(2015, time.September, 14), date(2016, time.October, 2)},
+		{hijri.UmmAlQuraCalendar, date(2024, time.March, 20),
+			date(2024, time.March, 11), date(2024, time.April, 10), date(2024, time.April, 9),
+			date(2023, time.July, 19), date(2024, time.July, 6)},
+		{persian.Calendar, date(2025, time.March, 10),
+			date(2025, time.February, 19), date(2025, time.March, 21), date(2025, time.March, 20),
+			date(2024, time.March, 20), date(2025, time.March, 20)},
+		{saka.Calendar, date(2024, time.March, 25),
+			date(2024, time.March, 21), date(2024, time.April, 21), date(2024, time.April, 20),
+			date(2024, time.March, 21), date(2025, time.March, 21)},
+	}
+
+	for _, c := range cases {
+		for _, a := range []struct {
+			name     string
+			fn       func(time.Time, Calendar) time.Time
+			expected time.Time
+		}{
+			{"FirstDayOfMonthIn", FirstDayOfMonthIn, c.firstOfMonth},
+			{"FirstDayOfNextMonthIn", FirstDayOfNextMonthIn, c.firstOfNext},
+			{"LastDayOfMonthIn", LastDayOfMonthIn, c.lastOfMonth},
+			{"FirstDayOfYearIn", FirstDayOfYearIn, c.firstOfYear},
+			{"LastDayOfYearIn", LastDayOfYearIn, c.lastOfYear},
+		} {
+			if got := a.fn(c.t, c.c); !got.Equal(a.expected) {
+				t.Errorf("%s(%v, %v) == %v, want %v", a.name, c.t, c.c, got, a.expected)
+			}
+		}
+	}
+
+	// the clock and location are kept
+	in := time.Date(2023, time.April, 1, 9, 15, 0, 0, nyc)
+	if got, expected := LastDayOfYearIn(in, chinese.Calendar), time.Date(2024, time.February, 9, 9, 15, 0, 0, nyc); !got.Equal(expected) || got.Location() != nyc {
+		t.Errorf("LastDayOfYearIn(%v, %v) == %v, want %v", in, chinese.Calendar, got, expected)
+	}
+
+	// the last days are found without the year after a calendar's table
+	edges := []struct {
+		c                       Calendar
+		t                       time.Time
+		lastOfMonth, lastOfYear time.Time
+	}{
+		{chinese.Calendar, date(2100, time.December, 10), date(2100, time.December, 30), date(2101, time.January, 28)},
+		{chinese.Calendar, date(2101, time.January, 10), date(2101, time.January, 28), date(2101, time.January, 28)},
+		{hijri.UmmAlQuraCalendar, date(2174, time.November, 7), date(2174, time.November, 25), date(2174, time.November, 25)},
+	}
+	for _, c := range edges {
+		if got := LastDayOfMonthIn(c.t, c.c); !got.Equal(c.lastOfMonth) {
+			t.Errorf("LastDayOfMonthIn(%v, %v) == %v, want %v", c.t, c.c, got, c.lastOfMonth)
+		}
+		if got := LastDayOfYearIn(c.t, c.c); !got.Equal(c.lastOfYear) {
+			t.Errorf("LastDayOfYearIn(%v, %v) == %v, want %v", c.t, c.c, got, c.lastOfYear)
+		}
+	}
+
+	// the Gregorian adjusters agree with the originals
+	for d := date(2015, time.January, 1); d.Year() == 2015; d = d.AddDate(0, 0, 1) {
+		if got, expected := LastDayOfMonthIn(d, Gregorian{}), LastDayOfMonth(d); !got.Equal(expected) {
+			t.Errorf("LastDayOfMonthIn(%v, Gregorian) == %v, want %v", d, got, expected)
+		}
+		if got, expected := FirstDayOfNextMonthIn(d, Gregorian{}), FirstDayOfNextMonth(d); !got.Equal(expected) {
+			t.Errorf("FirstDayOfNextMonthIn(%v, Gregorian) == %v, want %v", d, got, expected)
+		}
+	}
+}
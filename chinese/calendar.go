@@ -0,0 +1,56 @@
+package chinese
+
+import (
+	"time"
+
+	"github.com/justrudd/timex"
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Calendar is the Chinese calendar as a timex.Calendar, for use with its
+// generic adjusters. Months are numbered in the order of the year,
+// counting a leap month in its place, so in a year with a leap fourth
+// month the fifth month is month 6. As a timex.Calendar cannot return an
+// error, Calendar panics for dates outside of the table.
+var Calendar timex.Calendar = calendar{}
+
+type calendar struct{}
+
+func (calendar) Date(t time.Time) (int, time.Month, int) {
+	d, err := FromTime(t)
+	if err != nil {
+		panic(err)
+	}
+	return d.Year, time.Month(table[d.Year-firstYear].index(d.Month, d.Leap) + 1), d.Day
+}
+
+func (calendar) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	info := mustLookup(year)
+	n := starts[year-firstYear] + int64(day-1)
+	for k := 0; k < int(month)-1; k++ {
+		n += int64(info.days(k))
+	}
+	return calendrical.FromJDN(n, loc)
+}
+
+func (calendar) IsLeapYear(year int) bool {
+	return mustLookup(year).leapMonth() != 0
+}
+
+func (calendar) MonthsInYear(year int) int {
+	return mustLookup(year).count()
+}
+
+func (calendar) DaysInMonth(year int, month time.Month) int {
+	return mustLookup(year).days(int(month) - 1)
+}
+
+func (calendar) String() string { return "Chinese" }
+
+func mustLookup(year int) yearInfo {
+	info, err := lookup(year)
+	if err != nil {
+		panic(err)
+	}
+	return info
+}
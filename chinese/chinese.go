@@ -0,0 +1,157 @@
+// Package chinese converts between time.Time and dates of the Chinese
+// lunisolar calendar and computes the dates of its traditional
+// festivals.
+//
+// Months begin on the day of the new moon and have 29 or 30 days. A year
+// has 12 months, or 13 when a leap month is inserted to keep the
+// calendar in step with the seasons. The leap month repeats the number
+// of the month before it. Because the months follow the true motion of
+// the moon and sun as seen from China, they are not given by a rule and
+// this package uses a table of the years 1900 to 2100; conversions
+// outside of those years return an error.
+//
+// Years are identified by the Gregorian year in which they begin, so
+// 2024 is the year that began on February 10th, 2024.
+package chinese
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Date is a date in the Chinese calendar.
+type Date struct {
+	Year  int
+	Month int  // 1 to 12
+	Leap  bool // whether Month is the leap month that repeats it
+	Day   int
+}
+
+// String returns the date as in "2023 gui-mao, leap month 2, day 1".
+func (d Date) String() string {
+	month := "month "
+	if d.Leap {
+		month = "leap month "
+	}
+	return strconv.Itoa(d.Year) + " " + YearName(d.Year) + ", " + month +
+		strconv.Itoa(d.Month) + ", day " + strconv.Itoa(d.Day)
+}
+
+// FromTime returns the Chinese date of the civil date of `t`. An error is
+// returned for dates outside of the table.
+func FromTime(t time.Time) (Date, error) {
+	n := calendrical.JDN(t)
+	if n < starts[0] || n >= starts[len(starts)-1] {
+		return Date{}, fmt.Errorf("chinese: %s is outside of the table, %s to %s",
+			t.Format("2006-01-02"), calendrical.FromJDN(starts[0], time.UTC).Format("2006-01-02"),
+			calendrical.FromJDN(starts[len(starts)-1]-1, time.UTC).Format("2006-01-02"))
+	}
+	i := 0
+	for n >= starts[i+1] {
+		i++
+	}
+	year, info := firstYear+i, table[i]
+	day := int(n - starts[i])
+	k := 0
+	for days := info.days(k); day >= days; days = info.days(k) {
+		day -= days
+		k++
+	}
+	month, leap := info.month(k)
+	return Date{year, month, leap, day + 1}, nil
+}
+
+// Time returns a new time.Time at midnight in `loc` on the civil date
+// that corresponds to the Chinese date. An error is returned for dates
+// that do not exist, such as a leap month in a year without one, and for
+// years outside of the table.
+func (d Date) Time(loc *time.Location) (time.Time, error) {
+	days, err := DaysInMonth(d.Year, d.Month, d.Leap)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d.Day < 1 || d.Day > days {
+		return time.Time{}, fmt.Errorf("chinese: invalid date %v", d)
+	}
+	i := d.Year - firstYear
+	n := starts[i] + int64(d.Day) - 1
+	for k, end := 0, table[i].index(d.Month, d.Leap); k < end; k++ {
+		n += int64(table[i].days(k))
+	}
+	return calendrical.FromJDN(n, loc), nil
+}
+
+// LeapMonth returns the number of the month repeated by the leap month
+// of `year`, or 0 if the year has no leap month.
+func LeapMonth(year int) (int, error) {
+	info, err := lookup(year)
+	if err != nil {
+		return 0, err
+	}
+	return info.leapMonth(), nil
+}
+
+// MonthsInYear returns 13 for years with a leap month and 12 otherwise.
+func MonthsInYear(year int) (int, error) {
+	info, err := lookup(year)
+	if err != nil {
+		return 0, err
+	}
+	if info.leapMonth() != 0 {
+		return 13, nil
+	}
+	return 12, nil
+}
+
+// DaysInMonth returns the number of days, 29 or 30, in `month` of
+// `year`, or in its leap month when `leap` is set.
+func DaysInMonth(year, month int, leap bool) (int, error) {
+	info, err := lookup(year)
+	if err != nil {
+		return 0, err
+	}
+	if month < 1 || month > 12 || leap && info.leapMonth() != month {
+		return 0, fmt.Errorf("chinese: %d has no %s", year, Date{year, month, leap, 1}.monthName())
+	}
+	return info.days(info.index(month, leap)), nil
+}
+
+// DaysInYear returns the number of days in `year`: 353 to 355 in common
+// years and 383 to 385 in years with a leap month.
+func DaysInYear(year int) (int, error) {
+	if _, err := lookup(year); err != nil {
+		return 0, err
+	}
+	i := year - firstYear
+	return int(starts[i+1] - starts[i]), nil
+}
+
+var (
+	stems    = [10]string{"jia", "yi", "bing", "ding", "wu", "ji", "geng", "xin", "ren", "gui"}
+	branches = [12]string{"zi", "chou", "yin", "mao", "chen", "si", "wu", "wei", "shen", "you", "xu", "hai"}
+	animals  = [12]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+)
+
+// YearName returns the sexagenary name of `year`, its heavenly stem and
+// earthly branch in pinyin, as in "jia-chen" for 2024. The names repeat
+// every 60 years. Unlike the rest of the package it is defined for every
+// year.
+func YearName(year int) string {
+	return stems[calendrical.Mod(year-4, 10)] + "-" + branches[calendrical.Mod(year-4, 12)]
+}
+
+// Animal returns the animal of the zodiac for the earthly branch of
+// `year`, as in "Dragon" for 2024.
+func Animal(year int) string {
+	return animals[calendrical.Mod(year-4, 12)]
+}
+
+func (d Date) monthName() string {
+	if d.Leap {
+		return "leap month " + strconv.Itoa(d.Month)
+	}
+	return "month " + strconv.Itoa(d.Month)
+}
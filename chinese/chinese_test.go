@@ -0,0 +1,205 @@
+package chinese_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/chinese"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFromTime(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected Date
+	}{
+		{date(1900, time.January, 31), Date{1900, 1, false, 1}},
+		{date(2018, time.November, 8), Date{2018, 10, false, 1}},
+		{date(2020, time.May, 23), Date{2020, 4, true, 1}},
+		{date(2020, time.June, 21), Date{2020, 5, false, 1}},
+		{date(2023, time.March, 22), Date{2023, 2, true, 1}},
+		{date(2023, time.April, 19), Date{2023, 2, true, 29}},
+		{date(2023, time.April, 20), Date{2023, 3, false, 1}},
+		{date(2024, time.February, 9), Date{2023, 12, false, 30}},
+		{date(2024, time.February, 10), Date{2024, 1, false, 1}},
+		{date(2101, time.January, 28), Date{2100, 12, false, 29}},
+	}
+
+	for _, c := range cases {
+		got, err := FromTime(c.t)
+		if err != nil || got != c.expected {
+			t.Errorf("FromTime(%v) == %v, %v, want %v", c.t, got, err, c.expected)
+		}
+	}
+
+	for _, d := range []time.Time{date(1900, time.January, 30), date(2101, time.January, 29)} {
+		if got, err := FromTime(d); err == nil {
+			t.Errorf("FromTime(%v) == %v, want an error", d, got)
+		}
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	for d := date(1900, time.January, 31); d.Before(date(2101, time.January, 29)); d = d.AddDate(0, 0, 1) {
+		c, err := FromTime(d)
+		if err != nil {
+			t.Fatalf("FromTime(%v) returned %v", d, err)
+		}
+		got, err := c.Time(time.UTC)
+		if err != nil || !got.Equal(d) {
+			t.Fatalf("%v.Time(UTC) == %v, %v, want %v", c, got, err, d)
+		}
+	}
+
+	for _, d := range []Date{
+		{2024, 4, true, 1},
+		{2023, 2, true, 30},
+		{2023, 13, false, 1},
+		{2023, 1, false, 0},
+		{1899, 1, false, 1},
+		{2101, 1, false, 1},
+	} {
+		if got, err := d.Time(time.UTC); err == nil {
+			t.Errorf("%v.Time(UTC) == %v, want an error", d, got)
+		}
+	}
+}
+
+func TestYears(t *testing.T) {
+	cases := []struct {
+		year   int
+		leap   int
+		months int
+		days   int
+	}{
+		{1987, 6, 13, 384},
+		{2020, 4, 13, 384},
+		{2021, 0, 12, 354},
+		{2022, 0, 12, 355},
+		{2023, 2, 13, 384},
+		{2024, 0, 12, 354},
+		{2025, 6, 13, 384},
+	}
+
+	for _, c := range cases {
+		if got, err := LeapMonth(c.year); err != nil || got != c.leap {
+			t.Errorf("LeapMonth(%v) == %v, %v, want %v", c.year, got, err, c.leap)
+		}
+		if got, err := MonthsInYear(c.year); err != nil || got != c.months {
+			t.Errorf("MonthsInYear(%v) == %v, %v, want %v", c.year, got, err, c.months)
+		}
+		if got, err := DaysInYear(c.year); err != nil || got != c.days {
+			t.Errorf("DaysInYear(%v) == %v, %v, want %v", c.year, got, err, c.days)
+		}
+	}
+
+	if got, err := DaysInMonth(2023, 2, true); err != nil || got != 29 {
+		t.Errorf("DaysInMonth(2023, 2, true) == %v, %v, want 29", got, err)
+	}
+	if got, err := LeapMonth(2101); err == nil {
+		t.Errorf("LeapMonth(2101) == %v, want an error", got)
+	}
+}
+
+func TestYearName(t *testing.T) {
+	cases := []struct {
+		year         int
+		name, animal string
+	}{
+		{1984, "jia-zi", "Rat"},
+		{2023, "gui-mao", "Rabbit"},
+		{2024, "jia-chen", "Dragon"},
+		{2043, "gui-hai", "Pig"},
+		{2044, "jia-zi", "Rat"},
+		{3, "gui-hai", "Pig"},
+	}
+
+	for _, c := range cases {
+		if got := YearName(c.year); got != c.name {
+			t.Errorf("YearName(%v) == %v, want %v", c.year, got, c.name)
+		}
+		if got := Animal(c.year); got != c.animal {
+			t.Errorf("Animal(%v) == %v, want %v", c.year, got, c.animal)
+		}
+	}
+
+	if got, expected := (Date{2023, 2, true, 1}).String(), "2023 gui-mao, leap month 2, day 1"; got != expected {
+		t.Errorf("String() == %v, want %v", got, expected)
+	}
+}
+
+func TestFestivals(t *testing.T) {
+	cases := []struct {
+		year                                 int
+		newYear, qingming, dragon, midAutumn time.Time
+	}{
+		{1900, date(1900, time.January, 31), date(1900, time.April, 5), date(1900, time.June, 1), date(1900, time.September, 8)},
+		{2015, date(2015, time.February, 19), date(2015, time.April, 5), date(2015, time.June, 20), date(2015, time.September, 27)},
+		{2016, date(2016, time.February, 8), date(2016, time.April, 4), date(2016, time.June, 9), date(2016, time.September, 15)},
+		{2023, date(2023, time.January, 22), date(2023, time.April, 5), date(2023, time.June, 22), date(2023, time.September, 29)},
+		{2024, date(2024, time.February, 10), date(2024, time.April, 4), date(2024, time.June, 10), date(2024, time.September, 17)},
+		{2025, date(2025, time.January, 29), date(2025, time.April, 4), date(2025, time.May, 31), date(2025, time.October, 6)},
+		// new moons minutes before and after midnight in Beijing
+		{2027, date(2027, time.February, 6), date(2027, time.April, 5), date(2027, time.June, 9), date(2027, time.September, 15)},
+		{2030, date(2030, time.February, 3), date(2030, time.April, 5), date(2030, time.June, 5), date(2030, time.September, 12)},
+		{2100, date(2100, time.February, 9), date(2100, time.April, 5), date(2100, time.June, 12), date(2100, time.September, 18)},
+	}
+
+	for _, c := range cases {
+		for _, f := range []struct {
+			name     string
+			fn       func(int) (time.Time, error)
+			expected time.Time
+		}{
+			{"NewYear", NewYear, c.newYear},
+			{"DragonBoat", DragonBoat, c.dragon},
+			{"MidAutumn", MidAutumn, c.midAutumn},
+		} {
+			got, err := f.fn(c.year)
+			if err != nil || !got.Equal(f.expected) {
+				t.Errorf("%s(%v) == %v, %v, want %v", f.name, c.year, got, err, f.expected)
+			}
+		}
+		if got := Qingming(c.year); !got.Equal(c.qingming) {
+			t.Errorf("Qingming(%v) == %v, want %v", c.year, got, c.qingming)
+		}
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	var got []time.Time
+	for d := date(2024, time.January, 1); d.Year() == 2024; d = d.AddDate(0, 0, 1) {
+		if Holidays.IsHoliday(d) {
+			got = append(got, d)
+		}
+	}
+
+	expected := []time.Time{
+		date(2024, time.February, 10),
+		date(2024, time.April, 4),
+		date(2024, time.June, 10),
+		date(2024, time.September, 17),
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Holidays in 2024 == %v, want %v", got, expected)
+	}
+	for i := range got {
+		if !got[i].Equal(expected[i]) {
+			t.Fatalf("Holidays in 2024 == %v, want %v", got, expected)
+		}
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	d := date(2023, time.April, 1)
+	year, month, day := Calendar.Date(d)
+	if y, m, dd := 2023, 3, 11; year != y || int(month) != m || day != dd {
+		t.Errorf("Calendar.Date(%v) == %v, %v, %v, want %v, %v, %v", d, year, int(month), day, y, m, dd)
+	}
+	if got := Calendar.Time(year, month, day, time.UTC); !got.Equal(d) {
+		t.Errorf("Calendar.Time(%v, %v, %v, UTC) == %v, want %v", year, int(month), day, got, d)
+	}
+}
@@ -0,0 +1,97 @@
+package chinese
+
+import (
+	"math"
+	"time"
+
+	"github.com/justrudd/timex"
+)
+
+// The festivals below take a Gregorian year, in which each falls once,
+// and return the civil date of the festival at midnight UTC.
+
+// NewYear returns the date of the Lunar New Year, the first day of the
+// first month, between January 21st and February 20th.
+func NewYear(year int) (time.Time, error) {
+	return Date{year, 1, false, 1}.Time(time.UTC)
+}
+
+// DragonBoat returns the date of the Dragon Boat Festival, the fifth day
+// of the fifth month.
+func DragonBoat(year int) (time.Time, error) {
+	return Date{year, 5, false, 5}.Time(time.UTC)
+}
+
+// MidAutumn returns the date of the Mid-Autumn Festival, the fifteenth
+// day of the eighth month.
+func MidAutumn(year int) (time.Time, error) {
+	return Date{year, 8, false, 15}.Time(time.UTC)
+}
+
+// Qingming returns the date of the Qingming Festival, April 4th, 5th or
+// 6th. Unlike the other festivals it is not a lunar date but the solar
+// term that begins when the sun reaches 15° of ecliptic longitude, and
+// so it is computed for any year. The day is taken in China Standard
+// Time. The sun's position is accurate to within a few minutes, so the
+// day may be wrong in a year where the term falls within minutes of
+// midnight.
+func Qingming(year int) time.Time {
+	t := solarTerm(15, time.Date(year, time.April, 5, 0, 0, 0, 0, time.UTC))
+	y, m, d := t.In(chinaTime).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Holidays is a timex.HolidayCalendar of the Lunar New Year, Qingming,
+// the Dragon Boat Festival and the Mid-Autumn Festival. Outside of the
+// years of the table only Qingming is known.
+var Holidays timex.HolidayCalendar = timex.HolidayFunc(func(t time.Time) bool {
+	y, m, d := t.Date()
+	if m == time.April && Qingming(y).Day() == d {
+		return true
+	}
+	cd, err := FromTime(t)
+	if err != nil || cd.Leap {
+		return false
+	}
+	switch {
+	case cd.Month == 1 && cd.Day == 1,
+		cd.Month == 5 && cd.Day == 5,
+		cd.Month == 8 && cd.Day == 15:
+		return true
+	}
+	return false
+})
+
+var chinaTime = time.FixedZone("CST", 8*60*60)
+
+// solarTerm returns the instant near `t` when the apparent ecliptic
+// longitude of the sun is `degrees`.
+func solarTerm(degrees float64, t time.Time) time.Time {
+	day, frac := timex.ToJulianDay(t)
+	jd := float64(day) + frac
+	for i := 0; i < 5; i++ {
+		diff := math.Mod(degrees-sunLongitude(jd), 360)
+		if diff > 180 {
+			diff -= 360
+		} else if diff < -180 {
+			diff += 360
+		}
+		jd += diff * 365.2422 / 360
+	}
+	return timex.FromJulianDay(0, jd)
+}
+
+// sunLongitude returns the apparent ecliptic longitude of the sun in
+// degrees at the Julian Date `jd`, from the low accuracy method of
+// Meeus's Astronomical Algorithms, chapter 25, good to 0.01°.
+func sunLongitude(jd float64) float64 {
+	const rad = math.Pi / 180
+	T := (jd - 2451545) / 36525
+	L0 := 280.46646 + 36000.76983*T + 0.0003032*T*T
+	M := (357.52911 + 35999.05029*T - 0.0001537*T*T) * rad
+	C := (1.914602-0.004817*T-0.000014*T*T)*math.Sin(M) +
+		(0.019993-0.000101*T)*math.Sin(2*M) +
+		0.000289*math.Sin(3*M)
+	omega := (125.04 - 1934.136*T) * rad
+	return L0 + C - 0.00569 - 0.00478*math.Sin(omega)
+}
@@ -0,0 +1,108 @@
+package chinese
+
+import "fmt"
+
+const (
+	// firstYear is the first year of table.
+	firstYear = 1900
+	// epoch is the Julian Day Number of the first day of 1900, January
+	// 31st, 1900.
+	epoch = 2415051
+)
+
+// yearInfo describes the months of a year. Bit k is set when the k-th
+// month of the year, counting a leap month in its place, has 30 days
+// rather than 29. Bits 13 to 16 hold the number of the month repeated by
+// the leap month, or 0 when there is none.
+type yearInfo uint32
+
+// table holds the months of the years 1900 to 2100.
+var table = [...]yearInfo{
+	0x116d2, 0x00752, 0x00ea5, 0x0b64a, 0x0064b, 0x00a9b, 0x09556, 0x0056a, // 1900
+	0x00b59, 0x05752, 0x00752, 0x0db25, 0x00b25, 0x00a4b, 0x0b4ab, 0x002ad, // 1908
+	0x0056b, 0x06b69, 0x00da9, 0x0fd92, 0x00e92, 0x00d25, 0x0da4d, 0x00a56, // 1916
+	0x002b6, 0x095b5, 0x006d4, 0x00ea9, 0x05e92, 0x00e92, 0x0cd26, 0x0052b, // 1924
+	0x00a57, 0x0b2b6, 0x00b5a, 0x006d4, 0x06ec9, 0x00749, 0x0f693, 0x00a93, // 1932
+	0x0052b, 0x0ca5b, 0x00aad, 0x0056a, 0x09b55, 0x00ba4, 0x00b49, 0x05a93, // 1940
+	0x00a95, 0x0f52d, 0x00536, 0x00aad, 0x0b5aa, 0x005b2, 0x00da5, 0x07d4a, // 1948
+	0x00d4a, 0x10a95, 0x00a97, 0x00556, 0x0cab5, 0x00ad5, 0x006d2, 0x08ea5, // 1956
+	0x00ea5, 0x0064a, 0x06c97, 0x00a9b, 0x0f55a, 0x0056a, 0x00b69, 0x0b752, // 1964
+	0x00b52, 0x00b25, 0x0964b, 0x00a4b, 0x114ab, 0x002ad, 0x0056d, 0x0cb69, // 1972
+	0x00da9, 0x00d92, 0x09d25, 0x00d25, 0x15a4d, 0x00a56, 0x002b6, 0x0c5b5, // 1980
+	0x006d5, 0x00ea9, 0x0be92, 0x00e92, 0x00d26, 0x06a56, 0x00a57, 0x114d6, // 1988
+	0x0035a, 0x006d5, 0x0b6c9, 0x00749, 0x00693, 0x0952b, 0x0052b, 0x00a5b, // 1996
+	0x0555a, 0x0056a, 0x0fb55, 0x00ba4, 0x00b49, 0x0ba93, 0x00a95, 0x0052d, // 2004
+	0x08aad, 0x00ab5, 0x135aa, 0x005d2, 0x00da5, 0x0dd4a, 0x00d4a, 0x00c95, // 2012
+	0x0952e, 0x00556, 0x00ab5, 0x055b2, 0x006d2, 0x0cea5, 0x00725, 0x0064b, // 2020
+	0x0ac97, 0x00cab, 0x0055a, 0x06ad6, 0x00b69, 0x17752, 0x00b52, 0x00b25, // 2028
+	0x0da4b, 0x00a4b, 0x004ab, 0x0a55b, 0x005ad, 0x00b6a, 0x05b52, 0x00d92, // 2036
+	0x0fd25, 0x00d25, 0x00a55, 0x0b4ad, 0x004b6, 0x005b5, 0x06daa, 0x00ec9, // 2044
+	0x11e92, 0x00e92, 0x00d26, 0x0ca56, 0x00a57, 0x00556, 0x086d5, 0x00755, // 2052
+	0x00749, 0x06e93, 0x00693, 0x0f52b, 0x0052b, 0x00a5b, 0x0b55a, 0x0056a, // 2060
+	0x00b65, 0x0974a, 0x00b4a, 0x11a95, 0x00a95, 0x0052d, 0x0caad, 0x00ab5, // 2068
+	0x005aa, 0x08ba5, 0x00da5, 0x00d4a, 0x07c95, 0x00c96, 0x0f94e, 0x00556, // 2076
+	0x00ab5, 0x0b5b2, 0x006d2, 0x00ea5, 0x08e4a, 0x0068b, 0x10c97, 0x004ab, // 2084
+	0x0055b, 0x0cad6, 0x00b6a, 0x00752, 0x09725, 0x00b45, 0x00a8b, 0x0549b, // 2092
+	0x004ab, // 2100
+}
+
+// starts holds the Julian Day Number of the first day of each year of
+// table and of the year after the last.
+var starts = func() []int64 {
+	s := make([]int64, len(table)+1)
+	s[0] = epoch
+	for i, info := range table {
+		s[i+1] = s[i]
+		for k := 0; k < info.count(); k++ {
+			s[i+1] += int64(info.days(k))
+		}
+	}
+	return s
+}()
+
+func lookup(year int) (yearInfo, error) {
+	i := year - firstYear
+	if i < 0 || i >= len(table) {
+		return 0, fmt.Errorf("chinese: year %d is outside of the table, %d to %d",
+			year, firstYear, firstYear+len(table)-1)
+	}
+	return table[i], nil
+}
+
+func (y yearInfo) leapMonth() int {
+	return int(y >> 13 & 0xf)
+}
+
+// count returns the number of months in the year.
+func (y yearInfo) count() int {
+	if y.leapMonth() != 0 {
+		return 13
+	}
+	return 12
+}
+
+// days returns the length of the k-th month of the year.
+func (y yearInfo) days(k int) int {
+	return 29 + int(y>>uint(k)&1)
+}
+
+// index returns the position in the year of `month`, or its leap month.
+func (y yearInfo) index(month int, leap bool) int {
+	k := month - 1
+	if l := y.leapMonth(); l != 0 && (month > l || leap) {
+		k++
+	}
+	return k
+}
+
+// month returns the month at position k in the year.
+func (y yearInfo) month(k int) (month int, leap bool) {
+	l := y.leapMonth()
+	switch {
+	case l == 0 || k < l:
+		return k + 1, false
+	case k == l:
+		return l, true
+	}
+	return k, false
+}
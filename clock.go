@@ -0,0 +1,260 @@
+package timex
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time and of timers. Code that takes
+// a Clock instead of calling time.Now can be tested with a FakeClock.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	After(d time.Duration) <-chan time.Time
+	NewTimer(d time.Duration) Timer
+	NewTicker(d time.Duration) Ticker
+	Sleep(d time.Duration)
+}
+
+// Timer is the Clock version of time.Timer.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker is the Clock version of time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+	Reset(d time.Duration)
+}
+
+// RealClock is the Clock of the time package.
+type RealClock struct{}
+
+// Now returns time.Now().
+func (RealClock) Now() time.Time { return time.Now() }
+
+// Since returns time.Since(t).
+func (RealClock) Since(t time.Time) time.Duration { return time.Since(t) }
+
+// After returns time.After(d).
+func (RealClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// NewTimer returns a Timer for time.NewTimer(d).
+func (RealClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+// NewTicker returns a Ticker for time.NewTicker(d).
+func (RealClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+
+// Sleep calls time.Sleep(d).
+func (RealClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+type realTimer struct{ t *time.Timer }
+
+func (rt realTimer) C() <-chan time.Time        { return rt.t.C }
+func (rt realTimer) Stop() bool                 { return rt.t.Stop() }
+func (rt realTimer) Reset(d time.Duration) bool { return rt.t.Reset(d) }
+
+type realTicker struct{ t *time.Ticker }
+
+func (rt realTicker) C() <-chan time.Time   { return rt.t.C }
+func (rt realTicker) Stop()                 { rt.t.Stop() }
+func (rt realTicker) Reset(d time.Duration) { rt.t.Reset(d) }
+
+// FakeClock is a Clock whose time only moves when Advance or Set is
+// called: Advance lets time elapse and Set changes the wall clock.
+// Timers and tickers fire in the order they are due, ties in the order
+// they were created, and each sees Now at the time it is due. Like
+// those of the time package, their channels hold one value and values
+// that cannot be delivered are dropped. It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+	seq     int
+}
+
+// NewFakeClock returns a FakeClock set to `now`.
+func NewFakeClock(now time.Time) *FakeClock {
+	fc := &FakeClock{now: now}
+	fc.cond = sync.NewCond(&fc.mu)
+	return fc
+}
+
+// Now returns the time of the clock.
+func (fc *FakeClock) Now() time.Time {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.now
+}
+
+// Since returns the time elapsed on the clock since `t`.
+func (fc *FakeClock) Since(t time.Time) time.Duration {
+	return fc.Now().Sub(t)
+}
+
+// After returns a channel that receives the time of the clock once it
+// has advanced by `d`.
+func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
+	return fc.NewTimer(d).C()
+}
+
+// NewTimer returns a Timer that fires once the clock has advanced by
+// `d`. A timer for 0 or less fires immediately.
+func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	w := &fakeWaiter{fc: fc, c: make(chan time.Time, 1)}
+	w.Reset(d)
+	return w
+}
+
+// NewTicker returns a Ticker that fires every `d` of the clock. It
+// panics if `d` is not positive.
+func (fc *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("timex: non-positive interval for FakeClock.NewTicker")
+	}
+	w := &fakeWaiter{fc: fc, c: make(chan time.Time, 1)}
+	w.reset(d, d)
+	return fakeTicker{w}
+}
+
+// Sleep blocks until the clock has advanced by `d`.
+func (fc *FakeClock) Sleep(d time.Duration) {
+	<-fc.After(d)
+}
+
+// Advance moves the clock forward by `d`, firing the timers and tickers
+// that become due on the way.
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.advanceTo(fc.now.Add(d))
+}
+
+// Set changes the time of the clock to `t` without any time elapsing,
+// like setting the wall clock of a computer. No timers or tickers fire;
+// each keeps the time it has left, so it fires that much later than `t`.
+func (fc *FakeClock) Set(t time.Time) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	d := t.Sub(fc.now)
+	for _, w := range fc.waiters {
+		w.when = w.when.Add(d)
+	}
+	fc.now = t
+}
+
+// BlockUntil blocks until at least `n` timers and tickers are waiting
+// on the clock. Tests use it to know that the goroutines under test
+// have reached a timer before advancing the clock.
+func (fc *FakeClock) BlockUntil(n int) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	for len(fc.waiters) < n {
+		fc.cond.Wait()
+	}
+}
+
+func (fc *FakeClock) advanceTo(t time.Time) {
+	for len(fc.waiters) > 0 && !fc.waiters[0].when.After(t) {
+		w := fc.waiters[0]
+		fc.now = w.when
+		select {
+		case w.c <- w.when:
+		default:
+		}
+		if w.period > 0 {
+			w.when = w.when.Add(w.period)
+			w.seq = fc.nextSeq()
+			fc.sortWaiters()
+		} else {
+			fc.waiters = fc.waiters[1:]
+		}
+	}
+	fc.now = t
+}
+
+func (fc *FakeClock) nextSeq() int {
+	fc.seq++
+	return fc.seq
+}
+
+func (fc *FakeClock) sortWaiters() {
+	sort.Slice(fc.waiters, func(i, j int) bool {
+		wi, wj := fc.waiters[i], fc.waiters[j]
+		if !wi.when.Equal(wj.when) {
+			return wi.when.Before(wj.when)
+		}
+		return wi.seq < wj.seq
+	})
+}
+
+// remove removes `w` from the waiters and returns whether it was there.
+func (fc *FakeClock) remove(w *fakeWaiter) bool {
+	for i, o := range fc.waiters {
+		if o == w {
+			fc.waiters = append(fc.waiters[:i], fc.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// fakeWaiter is a timer, or a ticker when period is set, of a FakeClock.
+type fakeWaiter struct {
+	fc     *FakeClock
+	c      chan time.Time
+	when   time.Time
+	period time.Duration
+	seq    int
+}
+
+func (w *fakeWaiter) C() <-chan time.Time { return w.c }
+
+func (w *fakeWaiter) Stop() bool {
+	w.fc.mu.Lock()
+	defer w.fc.mu.Unlock()
+	return w.fc.remove(w)
+}
+
+func (w *fakeWaiter) Reset(d time.Duration) bool {
+	return w.reset(d, 0)
+}
+
+func (w *fakeWaiter) reset(d, period time.Duration) bool {
+	fc := w.fc
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	active := fc.remove(w)
+	w.when = fc.now.Add(d)
+	w.period = period
+	if d <= 0 {
+		select {
+		case w.c <- fc.now:
+		default:
+		}
+		return active
+	}
+	w.seq = fc.nextSeq()
+	fc.waiters = append(fc.waiters, w)
+	fc.sortWaiters()
+	fc.cond.Broadcast()
+	return active
+}
+
+type fakeTicker struct{ w *fakeWaiter }
+
+func (ft fakeTicker) C() <-chan time.Time { return ft.w.c }
+func (ft fakeTicker) Stop()               { ft.w.Stop() }
+
+func (ft fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("timex: non-positive interval for FakeClock Ticker.Reset")
+	}
+	ft.w.reset(d, d)
+}
@@ -0,0 +1,130 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+var _ Clock = RealClock{}
+var _ Clock = (*FakeClock)(nil)
+
+func TestFakeClockOrder(t *testing.T) {
+	start := date(2015, time.March, 8)
+	fc := NewFakeClock(start)
+
+	ticker := fc.NewTicker(time.Hour)
+	long := fc.NewTimer(150 * time.Minute)
+	short := fc.NewTimer(30 * time.Minute)
+	stopped := fc.NewTimer(time.Minute)
+	if !stopped.Stop() {
+		t.Error("Stop on an active timer returned false")
+	}
+
+	for i := 1; i <= 3; i++ {
+		fc.Advance(time.Hour)
+		if got, want := <-ticker.C(), start.Add(time.Duration(i)*time.Hour); got != want {
+			t.Errorf("tick %d == %v, want %v", i, got, want)
+		}
+	}
+
+	if got, want := <-short.C(), start.Add(30*time.Minute); got != want {
+		t.Errorf("short timer fired at %v, want %v", got, want)
+	}
+	if got, want := <-long.C(), start.Add(150*time.Minute); got != want {
+		t.Errorf("long timer fired at %v, want %v", got, want)
+	}
+	if got := fc.Since(start); got != 3*time.Hour {
+		t.Errorf("Since(start) == %v, want 3h", got)
+	}
+	if long.Stop() {
+		t.Error("Stop on a fired timer returned true")
+	}
+	select {
+	case v := <-stopped.C():
+		t.Errorf("stopped timer fired at %v", v)
+	default:
+	}
+}
+
+func TestFakeClockDroppedTicks(t *testing.T) {
+	fc := NewFakeClock(date(2015, time.March, 8))
+	ticker := fc.NewTicker(time.Minute)
+
+	fc.Advance(time.Hour)
+	if got, want := <-ticker.C(), date(2015, time.March, 8).Add(time.Minute); got != want {
+		t.Errorf("first tick == %v, want %v", got, want)
+	}
+	select {
+	case v := <-ticker.C():
+		t.Errorf("ticker kept a second tick %v", v)
+	default:
+	}
+
+	ticker.Reset(time.Second)
+	fc.Advance(time.Second)
+	if got, want := <-ticker.C(), fc.Now(); got != want {
+		t.Errorf("tick after Reset == %v, want %v", got, want)
+	}
+	ticker.Stop()
+}
+
+func TestFakeClockSleep(t *testing.T) {
+	fc := NewFakeClock(date(2015, time.March, 8))
+
+	done := make(chan time.Time)
+	for i := 0; i < 10; i++ {
+		go func() {
+			fc.Sleep(time.Minute)
+			done <- fc.Now()
+		}()
+	}
+
+	fc.BlockUntil(10)
+	fc.Advance(time.Minute)
+	for i := 0; i < 10; i++ {
+		if got, want := <-done, date(2015, time.March, 8).Add(time.Minute); got.Before(want) {
+			t.Errorf("Sleep returned at %v, want %v", got, want)
+		}
+	}
+
+	select {
+	case <-fc.After(0):
+	default:
+		t.Error("After(0) did not fire immediately")
+	}
+}
+
+func TestFakeClockSet(t *testing.T) {
+	fc := NewFakeClock(date(2015, time.March, 8))
+	timer := fc.NewTimer(time.Hour)
+
+	fc.Set(date(2015, time.March, 9))
+	if got := fc.Now(); got != date(2015, time.March, 9) {
+		t.Errorf("Now() == %v after Set, want %v", got, date(2015, time.March, 9))
+	}
+	select {
+	case v := <-timer.C():
+		t.Errorf("timer fired at %v when the clock was set", v)
+	default:
+	}
+
+	fc.Set(date(2015, time.March, 7))
+	fc.Advance(59 * time.Minute)
+	select {
+	case v := <-timer.C():
+		t.Errorf("timer fired at %v before its time", v)
+	default:
+	}
+	fc.Advance(time.Minute)
+	if got, want := <-timer.C(), date(2015, time.March, 7).Add(time.Hour); got != want {
+		t.Errorf("timer fired at %v, want %v", got, want)
+	}
+	if timer.Reset(time.Minute) {
+		t.Error("Reset on a fired timer returned true")
+	}
+	if !timer.Reset(time.Minute) {
+		t.Error("Reset on an active timer returned false")
+	}
+}
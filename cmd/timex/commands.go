@@ -0,0 +1,350 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justrudd/timex"
+)
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"next-business-day": {"next-business-day DATE", 1, nextBusinessDay},
+		"prev-business-day": {"prev-business-day DATE", 1, prevBusinessDay},
+		"is-business-day":   {"is-business-day DATE", 1, isBusinessDay},
+		"add-business-days": {"add-business-days DATE N", 2, addBusinessDays},
+		"adjust":            {"adjust DATE RULE", 2, adjust},
+		"nth":               {"nth YYYY-MM WEEKDAY N", 3, nth},
+		"diff":              {"diff DATE DATE", 2, diff},
+		"cal":               {"cal [YYYY | YYYY-MM]", -1, cal},
+		"holidays":          {"holidays YYYY", 1, holidays},
+		"convert":           {"convert TIME", 1, convert},
+		"format":            {"format TIME LAYOUT", 2, format},
+		"humanize":          {"humanize TIME", 1, humanize},
+	}
+}
+
+// rules are the adjustments of the adjust command.
+var rules = map[string]func(o *options, t time.Time) time.Time{
+	"following":          convention(timex.Following),
+	"modified-following": convention(timex.ModifiedFollowing),
+	"preceding":          convention(timex.Preceding),
+	"modified-preceding": convention(timex.ModifiedPreceding),
+	"month-end":          convention(timex.MonthEnd),
+	"first-day-of-month": adjuster(timex.FirstDayOfMonth),
+	"last-day-of-month":  adjuster(timex.LastDayOfMonth),
+	"first-day-of-year":  adjuster(timex.FirstDayOfYear),
+	"last-day-of-year":   adjuster(timex.LastDayOfYear),
+	"first-day-of-week": func(o *options, t time.Time) time.Time {
+		return timex.FirstDayOfWeek(t, o.locale.FirstDayOfWeek)
+	},
+	"last-day-of-week": func(o *options, t time.Time) time.Time {
+		return timex.LastDayOfWeek(t, o.locale.FirstDayOfWeek)
+	},
+	"next-imm":        adjuster(timex.NextIMMDate),
+	"third-wednesday": adjuster(timex.ThirdWednesday),
+	"third-friday":    adjuster(timex.ThirdFriday),
+}
+
+func convention(c timex.BusinessDayConvention) func(o *options, t time.Time) time.Time {
+	return func(o *options, t time.Time) time.Time {
+		return timex.Adjust(t, c, o.calendar)
+	}
+}
+
+func adjuster(a timex.Adjuster) func(o *options, t time.Time) time.Time {
+	return func(o *options, t time.Time) time.Time {
+		return a(t)
+	}
+}
+
+func nextBusinessDay(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	return timeResult(timex.NextBusinessDay(t, o.calendar)), nil
+}
+
+func prevBusinessDay(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	return timeResult(timex.PrevBusinessDay(t, o.calendar)), nil
+}
+
+func isBusinessDay(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	ok := timex.IsBusinessDay(t, o.calendar)
+	return result{
+		text: strconv.FormatBool(ok),
+		json: map[string]interface{}{"date": formatTime(t), "business_day": ok},
+	}, nil
+}
+
+func addBusinessDays(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		return result{}, fmt.Errorf("invalid number of days %q", args[1])
+	}
+	if n < 0 {
+		return timeResult(timex.BusinessDaysBefore(-n, o.calendar)(t)), nil
+	}
+	return timeResult(timex.BusinessDaysAfter(n, o.calendar)(t)), nil
+}
+
+func adjust(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	rule, ok := rules[args[1]]
+	if !ok {
+		return result{}, fmt.Errorf("unknown rule %q", args[1])
+	}
+	return timeResult(rule(o, t)), nil
+}
+
+func nth(o *options, args []string) (result, error) {
+	month, err := time.Parse("2006-01", args[0])
+	if err != nil {
+		return result{}, fmt.Errorf("invalid month %q, want YYYY-MM", args[0])
+	}
+	w, err := parseWeekday(args[1])
+	if err != nil {
+		return result{}, err
+	}
+	n, err := strconv.Atoi(args[2])
+	if err != nil || n == 0 || n < -5 || n > 5 {
+		return result{}, fmt.Errorf("invalid occurrence %q, want 1 to 5 or -1 to -5", args[2])
+	}
+	t := timex.NthDayOfWeek(month, w, n)
+	if t.Month() != month.Month() {
+		return result{}, fmt.Errorf("%s has no occurrence %d of %v", args[0], n, w)
+	}
+	return timeResult(t), nil
+}
+
+func diff(o *options, args []string) (result, error) {
+	a, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	b, err := parseTime(args[1])
+	if err != nil {
+		return result{}, err
+	}
+
+	// calendar days between the dates, as counted for the year fraction
+	days := timex.Actual365Fixed{}.DayCount(a, b)
+	business := businessDaysBetween(a, b, o.calendar)
+	fraction := timex.Actual365Fixed{}.YearFraction(a, b)
+	r := result{
+		text: fmt.Sprintf("%d days\n%d business days\n%.6f years (%v)",
+			days, business, fraction, timex.Actual365Fixed{}),
+		json: map[string]interface{}{
+			"from":          formatTime(a),
+			"to":            formatTime(b),
+			"days":          days,
+			"business_days": business,
+			"year_fraction": fraction,
+		},
+	}
+	// the duration is left out when it does not fit a time.Duration
+	if d := b.Sub(a); a.Add(d).Equal(b) {
+		r.text += "\n" + d.String()
+		r.json["duration"] = d.String()
+	}
+	return r, nil
+}
+
+// businessDaysBetween returns the number of business days after the
+// date of `a` up to and including the date of `b`, negative if `b` is
+// before `a`.
+func businessDaysBetween(a, b time.Time, cal timex.HolidayCalendar) int {
+	if b.Before(a) {
+		return -businessDaysBetween(b, a, cal)
+	}
+	a, b = timex.BeginningOfDay(a), timex.BeginningOfDay(b)
+	n := 0
+	for t := timex.NextBusinessDay(a, cal); !t.After(b); t = timex.NextBusinessDay(t, cal) {
+		n++
+	}
+	return n
+}
+
+func cal(o *options, args []string) (result, error) {
+	if len(args) == 0 {
+		now := o.now
+		text := timex.FormatMonth(now.Year(), now.Month(), o.locale)
+		return result{text: text, json: gridJSON(now.Year(), now.Month(), o)}, nil
+	}
+	if t, err := time.Parse("2006-01", args[0]); err == nil {
+		text := timex.FormatMonth(t.Year(), t.Month(), o.locale)
+		return result{text: text, json: gridJSON(t.Year(), t.Month(), o)}, nil
+	}
+	year, err := strconv.Atoi(args[0])
+	if err != nil || year < 1 || year > 9999 {
+		return result{}, fmt.Errorf("invalid year or month %q", args[0])
+	}
+	months := make([]interface{}, 12)
+	for m := time.January; m <= time.December; m++ {
+		months[m-1] = gridJSON(year, m, o)
+	}
+	return result{
+		text: timex.FormatYear(year, o.locale),
+		json: map[string]interface{}{"year": year, "months": months},
+	}, nil
+}
+
+func gridJSON(year int, month time.Month, o *options) map[string]interface{} {
+	var weeks [][]string
+	for _, week := range timex.MonthGrid(year, month, o.locale.FirstDayOfWeek) {
+		days := make([]string, 7)
+		empty := true
+		for i, gd := range week {
+			if gd.InMonth {
+				days[i] = formatTime(gd.Date)
+				empty = false
+			}
+		}
+		if !empty {
+			weeks = append(weeks, days)
+		}
+	}
+	return map[string]interface{}{"year": year, "month": int(month), "weeks": weeks}
+}
+
+func holidays(o *options, args []string) (result, error) {
+	year, err := strconv.Atoi(args[0])
+	if err != nil {
+		return result{}, fmt.Errorf("invalid year %q", args[0])
+	}
+	if o.calendar == nil {
+		return result{}, fmt.Errorf("no holiday calendar, use --calendar us")
+	}
+
+	var dates []string
+	for t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC); t.Year() == year; t = t.AddDate(0, 0, 1) {
+		if o.calendar.IsHoliday(t) {
+			dates = append(dates, formatTime(t))
+		}
+	}
+	return result{
+		text: strings.Join(dates, "\n"),
+		json: map[string]interface{}{"year": year, "holidays": dates},
+	}, nil
+}
+
+func convert(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+
+	jd, jdFrac := timex.ToJulianDay(t)
+	mjd, mjdFrac := timex.ToMJD(t)
+	isoYear, isoWeek := t.ISOWeek()
+	gps := timex.ToGPSTime(timex.ConvertTimeScale(t, timex.UTC, timex.GPS))
+	values := []struct {
+		name  string
+		value interface{}
+	}{
+		{"utc", t.UTC().Format(time.RFC3339Nano)},
+		{"unix", t.Unix()},
+		{"unix_ms", int64(timex.ToUnixMillis(t))},
+		{"julian_day", float64(jd) + jdFrac},
+		{"mjd", float64(mjd) + mjdFrac},
+		{"iso_week", fmt.Sprintf("%d-W%02d-%d", isoYear, isoWeek, (int(t.Weekday())+6)%7+1)},
+		{"ntp", fmt.Sprintf("%#016x", uint64(timex.ToNTP(t)))},
+		{"gps_week", gps.Week()},
+		{"gps_seconds_of_week", gps.TimeOfWeek().Seconds()},
+		{"tai_minus_utc", timex.TAIMinusUTC(t).Seconds()},
+	}
+	if serial, err := timex.ToExcelSerial(t, timex.Excel1900); err == nil {
+		values = append(values, struct {
+			name  string
+			value interface{}
+		}{"excel", serial})
+	}
+
+	var lines []string
+	js := map[string]interface{}{}
+	for _, v := range values {
+		text := fmt.Sprint(v.value)
+		if f, ok := v.value.(float64); ok {
+			text = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		lines = append(lines, fmt.Sprintf("%-20s %s", v.name, text))
+		js[v.name] = v.value
+	}
+	return result{text: strings.Join(lines, "\n"), json: js}, nil
+}
+
+func format(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	s := timex.Format(t, args[1], o.locale)
+	return result{text: s, json: map[string]interface{}{"time": formatTime(t), "formatted": s}}, nil
+}
+
+func humanize(o *options, args []string) (result, error) {
+	t, err := parseTime(args[0])
+	if err != nil {
+		return result{}, err
+	}
+	s := timex.Humanize(t, o.now)
+	return result{text: s, json: map[string]interface{}{"time": formatTime(t), "humanized": s}}, nil
+}
+
+func timeResult(t time.Time) result {
+	s := formatTime(t)
+	return result{
+		text: s,
+		json: map[string]interface{}{"date": s, "weekday": t.Weekday().String()},
+	}
+}
+
+// parseTime parses a date as midnight UTC or a time in RFC 3339.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date or time %q, want YYYY-MM-DD or RFC 3339", s)
+}
+
+// formatTime formats `t` as a date when it is midnight and in RFC 3339
+// otherwise.
+func formatTime(t time.Time) string {
+	if t.Equal(timex.BeginningOfDay(t)) {
+		return t.Format("2006-01-02")
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	l := strings.ToLower(s)
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		name := strings.ToLower(w.String())
+		if len(l) >= 2 && strings.HasPrefix(name, l) {
+			return w, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
@@ -0,0 +1,190 @@
+// Command timex exposes the date adjusters, business day calendars,
+// conversions and formatting of the timex package at the shell.
+//
+//	timex next-business-day 2026-12-24 --calendar us
+//	timex nth 2026-11 thu 4
+//	timex cal 2026
+//	timex diff 2026-01-31 2026-03-01
+//
+// With --json each result is printed as a JSON object. When a command is
+// given "-" or no arguments, its arguments are read from the lines of
+// the standard input, one run of the command per line.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justrudd/timex"
+)
+
+// options are the flags shared by the commands.
+type options struct {
+	json     bool
+	calendar timex.HolidayCalendar
+	locale   *timex.Locale
+	now      time.Time
+}
+
+// result is the output of a command: the text printed normally and the
+// object printed with --json.
+type result struct {
+	text string
+	json map[string]interface{}
+}
+
+type command struct {
+	usage string
+	nargs int // the number of arguments, -1 for 0 or 1
+	run   func(o *options, args []string) (result, error)
+}
+
+var calendars = map[string]timex.HolidayCalendar{
+	"none": nil,
+	"us":   timex.USFederal,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command line `args` and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("timex", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr) }
+	jsonOut := fs.Bool("json", false, "print results as JSON")
+	calendar := fs.String("calendar", "none", "holiday calendar for business days: none or us")
+	locale := fs.String("locale", "en-US", "locale for names and the first day of the week")
+
+	flags, positional := splitFlags(args)
+	if err := fs.Parse(flags); err != nil {
+		return 2
+	}
+	if len(positional) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	o := &options{json: *jsonOut, now: time.Now()}
+	var ok bool
+	if o.calendar, ok = calendars[*calendar]; !ok {
+		fmt.Fprintf(stderr, "timex: unknown calendar %q\n", *calendar)
+		return 2
+	}
+	if o.locale, ok = timex.LookupLocale(*locale); !ok {
+		fmt.Fprintf(stderr, "timex: unknown locale %q\n", *locale)
+		return 2
+	}
+
+	name, cmdArgs := positional[0], positional[1:]
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "timex: unknown command %q\n", name)
+		usage(stderr)
+		return 2
+	}
+
+	if (len(cmdArgs) == 0 && cmd.nargs > 0) || (len(cmdArgs) == 1 && cmdArgs[0] == "-") {
+		return runBatch(cmd, o, stdin, stdout, stderr)
+	}
+	if err := runOne(cmd, o, cmdArgs, stdout); err != nil {
+		fmt.Fprintf(stderr, "timex %s: %v\n", name, err)
+		return 1
+	}
+	return 0
+}
+
+// runBatch runs `cmd` for each line of `stdin`. A failing line is
+// reported and the rest still run.
+func runBatch(cmd command, o *options, stdin io.Reader, stdout, stderr io.Writer) int {
+	status := 0
+	s := bufio.NewScanner(stdin)
+	for line := 1; s.Scan(); line++ {
+		args := strings.Fields(s.Text())
+		if len(args) == 0 {
+			continue
+		}
+		if err := runOne(cmd, o, args, stdout); err != nil {
+			fmt.Fprintf(stderr, "timex: line %d: %v\n", line, err)
+			status = 1
+		}
+	}
+	if err := s.Err(); err != nil {
+		fmt.Fprintf(stderr, "timex: %v\n", err)
+		return 1
+	}
+	return status
+}
+
+func runOne(cmd command, o *options, args []string, stdout io.Writer) error {
+	if cmd.nargs >= 0 && len(args) != cmd.nargs || cmd.nargs < 0 && len(args) > 1 {
+		return fmt.Errorf("usage: timex %s", cmd.usage)
+	}
+	r, err := cmd.run(o, args)
+	if err != nil {
+		return err
+	}
+	if o.json {
+		b, err := json.Marshal(r.json)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "%s\n", b)
+		return nil
+	}
+	text := r.text
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	_, err = io.WriteString(stdout, text)
+	return err
+}
+
+// splitFlags separates the flags from the positional arguments so flags
+// may follow the command, as in "timex next-business-day 2026-12-24
+// --calendar us". Negative numbers are positional.
+func splitFlags(args []string) (flags, positional []string) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			return flags, append(positional, args[i+1:]...)
+		}
+		if len(a) < 2 || a[0] != '-' {
+			positional = append(positional, a)
+			continue
+		}
+		if _, err := strconv.Atoi(a); err == nil {
+			positional = append(positional, a)
+			continue
+		}
+		flags = append(flags, a)
+		name := strings.TrimLeft(a, "-")
+		if !strings.Contains(name, "=") && name != "json" && i+1 < len(args) {
+			i++
+			flags = append(flags, args[i])
+		}
+	}
+	return flags, positional
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: timex [--json] [--calendar none|us] [--locale tag] command [args | -]")
+	fmt.Fprintln(w, "\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\n", commands[name].usage)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		args     []string
+		stdin    string
+		expected string
+		status   int
+	}{
+		{[]string{"next-business-day", "2026-12-24", "--calendar", "us"}, "", "2026-12-28\n", 0},
+		{[]string{"next-business-day", "2026-12-24"}, "", "2026-12-25\n", 0},
+		{[]string{"--calendar=us", "prev-business-day", "2026-07-06"}, "", "2026-07-02\n", 0},
+		{[]string{"is-business-day", "2026-11-26", "--calendar", "us"}, "", "false\n", 0},
+		{[]string{"add-business-days", "2026-12-23", "-2"}, "", "2026-12-21\n", 0},
+		{[]string{"adjust", "2026-05-31", "modified-following"}, "", "2026-05-29\n", 0},
+		{[]string{"adjust", "2026-05-12", "next-imm"}, "", "2026-06-17\n", 0},
+		{[]string{"nth", "2026-11", "thu", "4"}, "", "2026-11-26\n", 0},
+		{[]string{"nth", "2026-05", "monday", "-1"}, "", "2026-05-25\n", 0},
+		{[]string{"nth", "2026-02", "mon", "5"}, "", "", 1},
+		{[]string{"diff", "2026-01-31", "2026-03-01"}, "", "29 days\n20 business days\n0.079452 years (ACT/365F)\n696h0m0s\n", 0},
+		{[]string{"--json", "diff", "2026-01-31", "2026-03-01", "--calendar", "us"}, "",
+			`{"business_days":19,"days":29,"duration":"696h0m0s","from":"2026-01-31","to":"2026-03-01","year_fraction":0.07945205479452055}` + "\n", 0},
+		{[]string{"diff", "2100-01-01", "1700-01-01"}, "", "-146097 days\n-104355 business days\n-400.265753 years (ACT/365F)\n", 0},
+		{[]string{"diff", "2026-03-01T23:00:00Z", "2026-03-02T01:00:00Z"}, "", "1 days\n1 business days\n0.002740 years (ACT/365F)\n2h0m0s\n", 0},
+		{[]string{"--json", "diff", "1700-01-01", "2100-01-01"}, "",
+			`{"business_days":104355,"days":146097,"from":"1700-01-01","to":"2100-01-01","year_fraction":400.26575342465753}` + "\n", 0},
+		{[]string{"cal", "2026-02", "--locale", "en-GB"}, "", "   February 2026\nMo Tu We Th Fr Sa Su\n                   1\n 2  3  4  5  6  7  8\n 9 10 11 12 13 14 15\n16 17 18 19 20 21 22\n23 24 25 26 27 28\n", 0},
+		{[]string{"--calendar", "us", "holidays", "2027"}, "", "2027-01-01\n2027-01-18\n2027-02-15\n2027-05-31\n2027-06-18\n2027-07-05\n2027-09-06\n2027-10-11\n2027-11-11\n2027-11-25\n2027-12-24\n2027-12-31\n", 0},
+		{[]string{"format", "2026-03-01", "Monday 2 January", "--locale", "de"}, "", "Sonntag 1 März\n", 0},
+		{[]string{"--json", "next-business-day", "-"}, "2026-12-24\n\n2026-12-31\n", `{"date":"2026-12-25","weekday":"Friday"}` + "\n" + `{"date":"2027-01-01","weekday":"Friday"}` + "\n", 0},
+		{[]string{"nth"}, "2026-11 thu 4\n2026-13 thu 4\n2026-05 fri -1\n", "2026-11-26\n2026-05-29\n", 1},
+		{[]string{"bogus"}, "", "", 2},
+		{[]string{"next-business-day", "2026-12-24", "--calendar", "mars"}, "", "", 2},
+		{[]string{"diff", "2026-01-31"}, "", "", 1},
+	}
+
+	for _, c := range cases {
+		var stdout, stderr bytes.Buffer
+		status := run(c.args, strings.NewReader(c.stdin), &stdout, &stderr)
+		if status != c.status {
+			t.Errorf("timex %v exited with %d, want %d: %s", c.args, status, c.status, stderr.String())
+		}
+		if got := stdout.String(); got != c.expected {
+			t.Errorf("timex %v printed %q, want %q", c.args, got, c.expected)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"convert", "2017-01-01T00:00:00Z"}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("timex convert exited with %d: %s", status, stderr.String())
+	}
+
+	for _, want := range []string{
+		"unix                 1483228800\n",
+		"mjd                  57754\n",
+		"julian_day           2457754.5\n",
+		"iso_week             2016-W52-7\n",
+		"gps_week             1930\n",
+		"gps_seconds_of_week  18\n",
+		"tai_minus_utc        37\n",
+		"excel                42736\n",
+	} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("timex convert printed\n%s\nwithout %q", stdout.String(), want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package timex
+
+import (
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Cutover is the change from the Julian to the Gregorian calendar as
+// made in a particular country. It is a Calendar. Dates before the
+// cutover are in the Julian calendar and dates from it on are in the
+// Gregorian, so its methods give the dates that were written at the
+// time, as found in historical records.
+//
+// The zero value changes calendars on Julian Day 0, in 4714 BC, and so
+// is the proleptic Gregorian calendar of time.Time for all later dates.
+// Years begin on January 1st even where, as in Britain before 1752, the
+// year was numbered from March 25th.
+type Cutover struct {
+	jdn int64 // the Julian Day Number of the first Gregorian day
+}
+
+var (
+	// CutoverRome is the cutover of the papal bull of 1582, followed by
+	// Italy, Spain and Portugal: Thursday, October 4th, 1582 was followed
+	// by Friday, October 15th.
+	CutoverRome = NewCutover(1582, time.October, 15)
+	// CutoverBritain is the cutover of Great Britain and its colonies:
+	// Wednesday, September 2nd, 1752 was followed by Thursday, September
+	// 14th.
+	CutoverBritain = NewCutover(1752, time.September, 14)
+	// CutoverRussia is the cutover of Soviet Russia: Wednesday, January
+	// 31st, 1918 was followed by Thursday, February 14th.
+	CutoverRussia = NewCutover(1918, time.February, 14)
+)
+
+// NewCutover returns a new Cutover whose first Gregorian day is the
+// given date.
+func NewCutover(year int, month time.Month, day int) Cutover {
+	return Cutover{calendrical.DateToJDN(year, month, day)}
+}
+
+// First returns the first Gregorian day at midnight UTC.
+func (c Cutover) First() time.Time {
+	return calendrical.FromJDN(c.jdn, time.UTC)
+}
+
+// Date returns the year, month and day of the civil date of `t` in the
+// calendar in use on that day.
+func (c Cutover) Date(t time.Time) (year int, month time.Month, day int) {
+	year, month, day = t.Date()
+	if n := calendrical.DateToJDN(year, month, day); n < c.jdn {
+		return julianFromJDN(n)
+	}
+	return year, month, day
+}
+
+// Time returns a new time.Time at midnight in `loc` for a date in the
+// calendar in use on that day. Dates that were skipped by the cutover
+// are taken as Julian dates, so October 10th, 1582 in Rome is the
+// Gregorian October 20th. Like time.Date, out of range months and days
+// are normalized.
+func (c Cutover) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return calendrical.FromJDN(c.toJDN(year, month, day), loc)
+}
+
+// IsLeapYear returns whether February of the year has 29 days.
+func (c Cutover) IsLeapYear(year int) bool {
+	return c.DaysInMonth(year, time.February) == 29
+}
+
+// MonthsInYear returns 12.
+func (Cutover) MonthsInYear(year int) int {
+	return 12
+}
+
+// DaysInMonth returns the number of days in the month for the year,
+// fewer than usual for the month of the cutover.
+func (c Cutover) DaysInMonth(year int, m time.Month) int {
+	return int(c.toJDN(year, m+1, 1) - c.toJDN(year, m, 1))
+}
+
+// DaysInYear returns the number of days in the year, fewer than usual
+// for the year of the cutover.
+func (c Cutover) DaysInYear(year int) int {
+	return int(c.toJDN(year+1, time.January, 1) - c.toJDN(year, time.January, 1))
+}
+
+// FirstDayOfMonth returns a new time.Time for the first day of the month
+// of `t` in the calendar in use. The clock of the time is not adjusted.
+func (c Cutover) FirstDayOfMonth(t time.Time) time.Time {
+	return FirstDayOfMonthIn(t, c)
+}
+
+// LastDayOfMonth returns a new time.Time for the last day of the month
+// of `t` in the calendar in use. The clock of the time is not adjusted.
+func (c Cutover) LastDayOfMonth(t time.Time) time.Time {
+	return LastDayOfMonthIn(t, c)
+}
+
+// FirstDayOfYear returns a new time.Time for the first day of the year
+// of `t` in the calendar in use. The clock of the time is not adjusted.
+func (c Cutover) FirstDayOfYear(t time.Time) time.Time {
+	return FirstDayOfYearIn(t, c)
+}
+
+// LastDayOfYear returns a new time.Time for the last day of the year of
+// `t` in the calendar in use. The clock of the time is not adjusted.
+func (c Cutover) LastDayOfYear(t time.Time) time.Time {
+	return LastDayOfYearIn(t, c)
+}
+
+// toJDN returns the Julian Day Number of a date in the calendar in use
+// on that day.
+func (c Cutover) toJDN(year int, month time.Month, day int) int64 {
+	if n := calendrical.DateToJDN(year, month, day); n >= c.jdn {
+		return n
+	}
+	return normalizedJulianToJDN(year, month, day)
+}
@@ -0,0 +1,108 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestCutoverDate(t *testing.T) {
+	cases := []struct {
+		c        Cutover
+		t        time.Time
+		year     int
+		month    time.Month
+		day      int
+		expected time.Time
+	}{
+		{CutoverRome, date(1582, time.October, 14), 1582, time.October, 4, date(1582, time.October, 14)},
+		{CutoverRome, date(1582, time.October, 15), 1582, time.October, 15, date(1582, time.October, 15)},
+		{CutoverRome, date(1492, time.October, 21), 1492, time.October, 12, date(1492, time.October, 21)},
+		{CutoverBritain, date(1582, time.October, 15), 1582, time.October, 5, date(1582, time.October, 15)},
+		{CutoverBritain, date(1732, time.February, 22), 1732, time.February, 11, date(1732, time.February, 22)},
+		{CutoverBritain, date(1752, time.September, 13), 1752, time.September, 2, date(1752, time.September, 13)},
+		{CutoverBritain, date(1752, time.September, 14), 1752, time.September, 14, date(1752, time.September, 14)},
+		{CutoverRussia, date(1917, time.November, 7), 1917, time.October, 25, date(1917, time.November, 7)},
+		{Cutover{}, date(1000, time.January, 1), 1000, time.January, 1, date(1000, time.January, 1)},
+	}
+
+	for _, c := range cases {
+		y, m, d := c.c.Date(c.t)
+		if y != c.year || m != c.month || d != c.day {
+			t.Errorf("%v.Date(%v) == %d, %s, %d, want %d, %s, %d", c.c.First(), c.t, y, m, d, c.year, c.month, c.day)
+		}
+		got := c.c.Time(c.year, c.month, c.day, utc)
+		if !got.Equal(c.expected) {
+			t.Errorf("%v.Time(%d, %s, %d) == %v, want %v", c.c.First(), c.year, c.month, c.day, got, c.expected)
+		}
+	}
+
+	// dates skipped by the cutover are Julian
+	got := CutoverRome.Time(1582, time.October, 10, utc)
+	if expected := date(1582, time.October, 20); !got.Equal(expected) {
+		t.Errorf("CutoverRome.Time(1582, October, 10) == %v, want %v", got, expected)
+	}
+	got = CutoverBritain.Time(1752, time.August, 32, utc)
+	if expected := date(1752, time.September, 12); !got.Equal(expected) {
+		t.Errorf("CutoverBritain.Time(1752, August, 32) == %v, want %v", got, expected)
+	}
+}
+
+func TestCutoverLengths(t *testing.T) {
+	cases := []struct {
+		c        Cutover
+		year     int
+		month    time.Month
+		leap     bool
+		days     int
+		yearDays int
+	}{
+		{CutoverRome, 1582, time.October, false, 21, 355},
+		{CutoverRome, 1700, time.February, false, 28, 365},
+		{CutoverBritain, 1700, time.February, true, 29, 366},
+		{CutoverBritain, 1752, time.September, true, 19, 355},
+		{CutoverBritain, 1800, time.February, false, 28, 365},
+		{CutoverRussia, 1918, time.February, false, 15, 352},
+		{CutoverRussia, 1900, time.February, true, 29, 366},
+	}
+
+	for _, c := range cases {
+		if got := c.c.IsLeapYear(c.year); got != c.leap {
+			t.Errorf("%v.IsLeapYear(%v) == %v, want %v", c.c.First(), c.year, got, c.leap)
+		}
+		if got := c.c.DaysInMonth(c.year, c.month); got != c.days {
+			t.Errorf("%v.DaysInMonth(%v, %s) == %v, want %v", c.c.First(), c.year, c.month, got, c.days)
+		}
+		if got := c.c.DaysInYear(c.year); got != c.yearDays {
+			t.Errorf("%v.DaysInYear(%v) == %v, want %v", c.c.First(), c.year, got, c.yearDays)
+		}
+	}
+}
+
+func TestCutoverAdjusters(t *testing.T) {
+	noon := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 12, 30, 0, 0, utc)
+	}
+	cases := []struct {
+		name     string
+		adjuster Adjuster
+		t        time.Time
+		expected time.Time
+	}{
+		{"FirstDayOfMonth", CutoverRome.FirstDayOfMonth, noon(1582, time.October, 20), noon(1582, time.October, 11)},
+		{"LastDayOfMonth", CutoverRome.LastDayOfMonth, noon(1582, time.October, 14), noon(1582, time.October, 31)},
+		{"FirstDayOfMonth", CutoverBritain.FirstDayOfMonth, noon(1700, time.March, 5), noon(1700, time.February, 11)},
+		{"LastDayOfMonth", CutoverBritain.LastDayOfMonth, noon(1700, time.February, 12), noon(1700, time.March, 11)},
+		{"FirstDayOfYear", CutoverBritain.FirstDayOfYear, noon(1752, time.December, 25), noon(1752, time.January, 12)},
+		{"LastDayOfYear", CutoverBritain.LastDayOfYear, noon(1751, time.June, 1), noon(1752, time.January, 11)},
+		{"LastDayOfYear", CutoverBritain.LastDayOfYear, noon(1752, time.June, 1), noon(1752, time.December, 31)},
+	}
+
+	for _, c := range cases {
+		got := c.adjuster(c.t)
+		if !got.Equal(c.expected) {
+			t.Errorf("%s(%v) == %v, want %v", c.name, c.t, got, c.expected)
+		}
+	}
+}
@@ -2,13 +2,25 @@ package timex
 
 import "time"
 
+// Adjuster moves a time.Time to a related time. The adjustors in this
+// package that take extra arguments can be turned into an Adjuster with
+// a closure, and several of them are provided ready made.
+type Adjuster func(t time.Time) time.Time
+
+// Chain returns an Adjuster that applies `adjusters` in order.
+func Chain(adjusters ...Adjuster) Adjuster {
+	return func(t time.Time) time.Time {
+		for _, a := range adjusters {
+			t = a(t)
+		}
+		return t
+	}
+}
+
 // FirstDayOfMonth returns a new time.Time in the same month set to the
 // first day of the month. The clock of the time is not adjusted.
 func FirstDayOfMonth(t time.Time) time.Time {
-	y, m, _ := t.Date()
-	h, mi, s := t.Clock()
-
-	return time.Date(y, m, 1, h, mi, s, t.Nanosecond(), t.Location())
+	return FirstDayOfMonthIn(t, Gregorian{})
 }
 
 // FirstDayOfNextMonth returns a new time.Time for the first day of the
@@ -26,6 +38,13 @@ func FirstDayOfNextMonth(t time.Time) time.Time {
 	return time.Date(y+ya, nm, 1, h, mi, s, t.Nanosecond(), t.Location())
 }
 
+// FirstDayOfWeek returns a new time.Time for the first day of the week
+// containing `t`, where weeks begin on `weekStart`. The clock of the
+// time is not adjusted.
+func FirstDayOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return PrevDayOfWeek(t, weekStart, false)
+}
+
 // FirstDayOfYear returns a new time.Time for first day in the current year.
 // The clock of the time is not adjusted.
 func FirstDayOfYear(t time.Time) time.Time {
@@ -45,11 +64,14 @@ func FirstInMonth(t time.Time, w time.Weekday) time.Time {
 // LastDayOfMonth returns a new time.Time in the same month set to the
 // last day of the month. The clock of the time is not adjusted.
 func LastDayOfMonth(t time.Time) time.Time {
-	y, m, _ := t.Date()
-	h, mi, s := t.Clock()
+	return LastDayOfMonthIn(t, Gregorian{})
+}
 
-	d := DaysInMonth(y, m)
-	return time.Date(y, m, d, h, mi, s, t.Nanosecond(), t.Location())
+// LastDayOfWeek returns a new time.Time for the last day of the week
+// containing `t`, where weeks begin on `weekStart`. The clock of the
+// time is not adjusted.
+func LastDayOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return NextDayOfWeek(t, PrevWeekday(weekStart), false)
 }
 
 // LastDayOfYear returns a new time.Time for first day in the current year.
@@ -71,6 +71,44 @@ func TestFirstDayOfNextMonth(t *testing.T) {
 	}
 }
 
+func TestFirstDayOfWeek(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		// Wednesday
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Sunday,
+			time.Date(2015, time.June, 28, 15, 41, 0, 0, local),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Monday,
+			time.Date(2015, time.June, 29, 15, 41, 0, 0, local),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Saturday,
+			time.Date(2015, time.June, 27, 15, 41, 0, 0, local),
+		},
+		// already at the start of the week
+		{
+			time.Date(2016, time.January, 4, 9, 15, 56, 0, nyc),
+			time.Monday,
+			time.Date(2016, time.January, 4, 9, 15, 56, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := FirstDayOfWeek(c.t, c.weekStart)
+		if got != c.expected {
+			t.Errorf("FirstDayOfWeek(%v, %s) == %v, want %v", c.t, c.weekStart, got, c.expected)
+		}
+	}
+}
+
 func TestFirstDayOfYear(t *testing.T) {
 	cases := []struct {
 		t, expected time.Time
@@ -150,6 +188,49 @@ func TestLastDayOfMonth(t *testing.T) {
 	}
 }
 
+func TestLastDayOfWeek(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		// Wednesday
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Sunday,
+			time.Date(2015, time.July, 4, 15, 41, 0, 0, local),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Monday,
+			time.Date(2015, time.July, 5, 15, 41, 0, 0, local),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, local),
+			time.Saturday,
+			time.Date(2015, time.July, 3, 15, 41, 0, 0, local),
+		},
+		// already at the end of the week, crossing the year
+		{
+			time.Date(2016, time.January, 3, 9, 15, 56, 0, nyc),
+			time.Monday,
+			time.Date(2016, time.January, 3, 9, 15, 56, 0, nyc),
+		},
+		{
+			time.Date(2015, time.December, 29, 9, 15, 56, 0, nyc),
+			time.Monday,
+			time.Date(2016, time.January, 3, 9, 15, 56, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := LastDayOfWeek(c.t, c.weekStart)
+		if got != c.expected {
+			t.Errorf("LastDayOfWeek(%v, %s) == %v, want %v", c.t, c.weekStart, got, c.expected)
+		}
+	}
+}
+
 func TestLastDayOfYear(t *testing.T) {
 	cases := []struct {
 		t, expected time.Time
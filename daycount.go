@@ -0,0 +1,273 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayCounter is a financial day count convention. It determines the
+// number of days between two dates and the fraction of a year they
+// span, which is what accrued interest is computed from.
+//
+// Only the dates of `start` and `end` are used; the clocks are ignored.
+type DayCounter interface {
+	DayCount(start, end time.Time) int
+	YearFraction(start, end time.Time) float64
+}
+
+// Thirty360BondBasis is the 30/360 convention of ISDA 2006 section
+// 4.16(f), also known as Bond Basis. The 31st of a month is treated as
+// the 30th, but the 31st at the end of the period only when the period
+// starts on the 30th or 31st.
+type Thirty360BondBasis struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (Thirty360BondBasis) DayCount(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return thirty360(y1, m1, d1, y2, m2, d2)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Thirty360BondBasis) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 360
+}
+
+func (Thirty360BondBasis) String() string { return "30/360" }
+
+// Thirty360US is the 30/360 US convention used for US agency and
+// corporate bonds. It extends Thirty360BondBasis by also treating the
+// last day of February as the 30th.
+type Thirty360US struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (Thirty360US) DayCount(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	febEnd1 := isLastDayOfFebruary(y1, m1, d1)
+	if febEnd1 && isLastDayOfFebruary(y2, m2, d2) {
+		d2 = 30
+	}
+	if febEnd1 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+	return thirty360(y1, m1, d1, y2, m2, d2)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Thirty360US) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 360
+}
+
+func (Thirty360US) String() string { return "30/360 US" }
+
+// Thirty360E is the 30E/360 convention of ISDA 2006 section 4.16(g),
+// also known as Eurobond Basis. The 31st of a month is always treated
+// as the 30th.
+type Thirty360E struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (Thirty360E) DayCount(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 {
+		d2 = 30
+	}
+	return thirty360(y1, m1, d1, y2, m2, d2)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Thirty360E) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 360
+}
+
+func (Thirty360E) String() string { return "30E/360" }
+
+// Thirty360EISDA is the 30E/360 (ISDA) convention of ISDA 2006 section
+// 4.16(h). The last day of any month is treated as the 30th, except
+// when the period ends on the last day of February at `Maturity`.
+type Thirty360EISDA struct {
+	Maturity time.Time
+}
+
+// DayCount returns the number of days from `start` to `end`.
+func (c Thirty360EISDA) DayCount(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == DaysInMonth(y1, m1) {
+		d1 = 30
+	}
+	if d2 == DaysInMonth(y2, m2) && !(m2 == time.February && daysBetweenDates(end, c.Maturity) == 0) {
+		d2 = 30
+	}
+	return thirty360(y1, m1, d1, y2, m2, d2)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Thirty360EISDA) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 360
+}
+
+func (Thirty360EISDA) String() string { return "30E/360 ISDA" }
+
+// Actual360 is the ACT/360 convention, the actual number of days over
+// a 360 day year.
+type Actual360 struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (Actual360) DayCount(start, end time.Time) int {
+	return daysBetweenDates(start, end)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Actual360) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 360
+}
+
+func (Actual360) String() string { return "ACT/360" }
+
+// Actual365Fixed is the ACT/365 Fixed convention, the actual number of
+// days over a 365 day year regardless of leap years.
+type Actual365Fixed struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (Actual365Fixed) DayCount(start, end time.Time) int {
+	return daysBetweenDates(start, end)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c Actual365Fixed) YearFraction(start, end time.Time) float64 {
+	return float64(c.DayCount(start, end)) / 365
+}
+
+func (Actual365Fixed) String() string { return "ACT/365F" }
+
+// ActualActualISDA is the ACT/ACT ISDA convention. Days falling in a
+// leap year are divided by 366 and the rest by 365.
+type ActualActualISDA struct{}
+
+// DayCount returns the number of days from `start` to `end`.
+func (ActualActualISDA) DayCount(start, end time.Time) int {
+	return daysBetweenDates(start, end)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c ActualActualISDA) YearFraction(start, end time.Time) float64 {
+	if daysBetweenDates(start, end) < 0 {
+		return -c.YearFraction(end, start)
+	}
+
+	y1, y2 := start.Year(), end.Year()
+	if y1 == y2 {
+		return float64(daysBetweenDates(start, end)) / daysInYear(y1)
+	}
+	jan1 := time.Date(y1+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastJan1 := time.Date(y2, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return float64(daysBetweenDates(start, jan1))/daysInYear(y1) +
+		float64(y2-y1-1) +
+		float64(daysBetweenDates(lastJan1, end))/daysInYear(y2)
+}
+
+func (ActualActualISDA) String() string { return "ACT/ACT ISDA" }
+
+// ActualActualICMA is the ACT/ACT ICMA convention used for bonds. The
+// days of a period are divided by the length of the coupon period they
+// fall in times the number of coupons per year.
+//
+// `RefStart` and `RefEnd` are the regular coupon period the calculation
+// belongs to. When they are zero the calculation period itself is used.
+// Stubs that are longer than a regular period are split into notional
+// coupon periods stepping back from `RefStart` or forward from `RefEnd`.
+//
+// `Frequency` must divide 12, for periods of whole months, or 364, for
+// periods of whole weeks. YearFraction panics on any other frequency.
+type ActualActualICMA struct {
+	Frequency int // coupons per year, 0 is treated as 1
+	RefStart  time.Time
+	RefEnd    time.Time
+}
+
+// DayCount returns the number of days from `start` to `end`.
+func (ActualActualICMA) DayCount(start, end time.Time) int {
+	return daysBetweenDates(start, end)
+}
+
+// YearFraction returns the fraction of a year from `start` to `end`.
+func (c ActualActualICMA) YearFraction(start, end time.Time) float64 {
+	if daysBetweenDates(start, end) < 0 {
+		return -c.YearFraction(end, start)
+	}
+
+	f := c.Frequency
+	if f == 0 {
+		f = 1
+	}
+	var step func(t time.Time, k int) time.Time
+	switch {
+	case f > 0 && 12%f == 0:
+		step = func(t time.Time, k int) time.Time { return addMonths(t, k*12/f) }
+	case f > 0 && 364%f == 0:
+		step = func(t time.Time, k int) time.Time { return t.AddDate(0, 0, k*364/f) }
+	default:
+		panic(fmt.Sprintf("timex: ACT/ACT ICMA frequency %d does not divide 12 or 364", c.Frequency))
+	}
+	rs, re := c.RefStart, c.RefEnd
+	if rs.IsZero() || re.IsZero() {
+		rs, re = start, end
+	}
+
+	// portion returns the part of [start, end] falling in the coupon
+	// period [ps, pe] as a fraction of a year.
+	portion := func(ps, pe time.Time) float64 {
+		from, to := ps, pe
+		if daysBetweenDates(from, start) > 0 {
+			from = start
+		}
+		if daysBetweenDates(to, end) < 0 {
+			to = end
+		}
+		d := daysBetweenDates(from, to)
+		if d <= 0 {
+			return 0
+		}
+		return float64(d) / float64(f*daysBetweenDates(ps, pe))
+	}
+
+	yf := portion(rs, re)
+	for k := 1; daysBetweenDates(start, step(rs, 1-k)) > 0; k++ {
+		yf += portion(step(rs, -k), step(rs, 1-k))
+	}
+	for k := 1; daysBetweenDates(step(re, k-1), end) > 0; k++ {
+		yf += portion(step(re, k-1), step(re, k))
+	}
+	return yf
+}
+
+func (ActualActualICMA) String() string { return "ACT/ACT ICMA" }
+
+func thirty360(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) int {
+	return 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+}
+
+func isLastDayOfFebruary(y int, m time.Month, d int) bool {
+	return m == time.February && d == DaysInMonth(y, m)
+}
+
+func daysInYear(y int) float64 {
+	return float64(DaysInYear(y))
+}
@@ -0,0 +1,153 @@
+package timex_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, utc)
+}
+
+func TestThirty360DayCount(t *testing.T) {
+	cases := []struct {
+		start, end               time.Time
+		bond, us, euro, euroISDA int
+		euroISDAMaturity         time.Time
+		euroISDAAtMaturity       int
+	}{
+		{date(2007, time.January, 15), date(2007, time.January, 30), 15, 15, 15, 15, time.Time{}, 15},
+		{date(2007, time.January, 31), date(2007, time.February, 28), 28, 28, 28, 30, time.Time{}, 30},
+		{date(2007, time.February, 28), date(2007, time.March, 31), 33, 30, 32, 30, time.Time{}, 30},
+		{date(2007, time.March, 30), date(2007, time.March, 31), 0, 0, 0, 0, time.Time{}, 0},
+		{date(2007, time.February, 28), date(2008, time.February, 29), 361, 360, 361, 360, date(2008, time.February, 29), 359},
+		{date(2006, time.August, 31), date(2007, time.February, 28), 178, 178, 178, 180, date(2007, time.February, 28), 178},
+	}
+
+	for _, c := range cases {
+		if got := (Thirty360BondBasis{}).DayCount(c.start, c.end); got != c.bond {
+			t.Errorf("Thirty360BondBasis.DayCount(%v, %v) == %d, want %d", c.start, c.end, got, c.bond)
+		}
+		if got := (Thirty360US{}).DayCount(c.start, c.end); got != c.us {
+			t.Errorf("Thirty360US.DayCount(%v, %v) == %d, want %d", c.start, c.end, got, c.us)
+		}
+		if got := (Thirty360E{}).DayCount(c.start, c.end); got != c.euro {
+			t.Errorf("Thirty360E.DayCount(%v, %v) == %d, want %d", c.start, c.end, got, c.euro)
+		}
+		if got := (Thirty360EISDA{}).DayCount(c.start, c.end); got != c.euroISDA {
+			t.Errorf("Thirty360EISDA.DayCount(%v, %v) == %d, want %d", c.start, c.end, got, c.euroISDA)
+		}
+		dc := Thirty360EISDA{Maturity: c.euroISDAMaturity}
+		if got := dc.DayCount(c.start, c.end); got != c.euroISDAAtMaturity {
+			t.Errorf("Thirty360EISDA{%v}.DayCount(%v, %v) == %d, want %d", c.euroISDAMaturity, c.start, c.end, got, c.euroISDAAtMaturity)
+		}
+	}
+}
+
+// The ACT/ACT examples are from the ISDA paper "EMU and Market
+// Conventions: Recent Developments".
+func TestYearFraction(t *testing.T) {
+	cases := []struct {
+		dc         DayCounter
+		start, end time.Time
+		expected   float64
+	}{
+		{Actual360{}, date(2007, time.January, 15), date(2007, time.July, 15), 181.0 / 360},
+		{Actual365Fixed{}, date(2007, time.January, 15), date(2007, time.July, 15), 181.0 / 365},
+		{Actual365Fixed{}, date(2008, time.January, 1), date(2009, time.January, 1), 366.0 / 365},
+		// further apart than a time.Duration reaches
+		{Actual365Fixed{}, date(1700, time.January, 1), date(2100, time.January, 1), 146097.0 / 365},
+		{Actual360{}, date(2100, time.January, 1), date(1700, time.January, 1), -146097.0 / 360},
+		{Thirty360BondBasis{}, date(2007, time.January, 15), date(2007, time.July, 15), 0.5},
+		{Thirty360E{}, date(2007, time.January, 31), date(2007, time.July, 31), 0.5},
+
+		// regular period
+		{ActualActualISDA{}, date(2003, time.November, 1), date(2004, time.May, 1), 0.49772438056741},
+		{ActualActualICMA{Frequency: 2}, date(2003, time.November, 1), date(2004, time.May, 1), 0.5},
+
+		// short first period
+		{ActualActualISDA{}, date(1999, time.February, 1), date(1999, time.July, 1), 0.41095890410959},
+		{
+			ActualActualICMA{Frequency: 1, RefStart: date(1998, time.July, 1), RefEnd: date(1999, time.July, 1)},
+			date(1999, time.February, 1), date(1999, time.July, 1),
+			0.41095890410959,
+		},
+
+		// long first period
+		{ActualActualISDA{}, date(2002, time.August, 15), date(2003, time.July, 15), 0.91506849315068},
+		{
+			ActualActualICMA{Frequency: 2, RefStart: date(2003, time.January, 15), RefEnd: date(2003, time.July, 15)},
+			date(2002, time.August, 15), date(2003, time.July, 15),
+			0.91576086956522,
+		},
+
+		// short final period
+		{ActualActualISDA{}, date(1999, time.July, 30), date(2000, time.January, 30), 0.50389250692417},
+		{ActualActualICMA{Frequency: 2}, date(1999, time.July, 30), date(2000, time.January, 30), 0.5},
+		{ActualActualISDA{}, date(2000, time.January, 30), date(2000, time.June, 30), 0.41530054644809},
+		{
+			ActualActualICMA{Frequency: 2, RefStart: date(2000, time.January, 30), RefEnd: date(2000, time.July, 30)},
+			date(2000, time.January, 30), date(2000, time.June, 30),
+			0.41758241758242,
+		},
+
+		// weekly coupons with a long first period
+		{ActualActualICMA{Frequency: 52}, date(2020, time.February, 1), date(2020, time.February, 8), 1.0 / 52},
+		{
+			ActualActualICMA{Frequency: 52, RefStart: date(2020, time.February, 1), RefEnd: date(2020, time.February, 8)},
+			date(2020, time.January, 20), date(2020, time.February, 8),
+			19.0 / 364,
+		},
+
+		// multiple years and reversed dates
+		{ActualActualISDA{}, date(2003, time.July, 1), date(2006, time.July, 1), 3},
+		{ActualActualISDA{}, date(2004, time.May, 1), date(2003, time.November, 1), -0.49772438056741},
+	}
+
+	for _, c := range cases {
+		got := c.dc.YearFraction(c.start, c.end)
+		if math.Abs(got-c.expected) > 1e-12 {
+			t.Errorf("%s.YearFraction(%v, %v) == %.14f, want %.14f", c.dc, c.start, c.end, got, c.expected)
+		}
+	}
+}
+
+func TestActualActualICMAInvalidFrequency(t *testing.T) {
+	for _, f := range []int{-2, 5, 10, 500} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ActualActualICMA{Frequency: %d}.YearFraction should panic", f)
+				}
+			}()
+			ActualActualICMA{Frequency: f}.YearFraction(date(2020, time.January, 1), date(2020, time.July, 1))
+		}()
+	}
+}
+
+func TestDayCounterString(t *testing.T) {
+	cases := []struct {
+		dc       DayCounter
+		expected string
+	}{
+		{Thirty360BondBasis{}, "30/360"},
+		{Thirty360US{}, "30/360 US"},
+		{Thirty360E{}, "30E/360"},
+		{Thirty360EISDA{}, "30E/360 ISDA"},
+		{Actual360{}, "ACT/360"},
+		{Actual365Fixed{}, "ACT/365F"},
+		{ActualActualISDA{}, "ACT/ACT ISDA"},
+		{ActualActualICMA{}, "ACT/ACT ICMA"},
+	}
+
+	for _, c := range cases {
+		got := fmt.Sprint(c.dc)
+		if got != c.expected {
+			t.Errorf("String() == %q, want %q", got, c.expected)
+		}
+	}
+}
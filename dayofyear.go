@@ -0,0 +1,62 @@
+package timex
+
+import "time"
+
+// DayOfYear returns the day of the year of `t`, from 1 to 365, or 366
+// in leap years.
+func DayOfYear(t time.Time) int {
+	return t.YearDay()
+}
+
+// DaysRemainingInYear returns the number of days of the year left after
+// the day of `t`, 0 on December 31st.
+func DaysRemainingInYear(t time.Time) int {
+	return DaysInYear(t.Year()) - t.YearDay()
+}
+
+// FromOrdinal returns a new time.Time at midnight in `loc` for day
+// `yday` of `year`, where January 1st is day 1. Like time.Date, days
+// outside of the year are normalized, so day 0 is December 31st of the
+// year before.
+func FromOrdinal(year, yday int, loc *time.Location) time.Time {
+	return time.Date(year, time.January, yday, 0, 0, 0, 0, loc)
+}
+
+// PercentOfMonthElapsed returns how much of the month of `t` has passed,
+// from 0 at the start of the 1st to just under 100 at the end of the
+// last day. Days count as 24 hours of the wall clock, so DST changes do
+// not make the result jump.
+func PercentOfMonthElapsed(t time.Time) float64 {
+	days := DaysInMonth(t.Year(), t.Month())
+	return 100 * (float64(t.Day()-1) + fractionOfDay(t)) / float64(days)
+}
+
+// PercentOfQuarterElapsed returns how much of the calendar quarter of
+// `t` has passed. See PercentOfMonthElapsed.
+func PercentOfQuarterElapsed(t time.Time) float64 {
+	first := (t.Month()-1)/3*3 + 1
+	elapsed, days := 0, 0
+	for m := first; m < first+3; m++ {
+		n := DaysInMonth(t.Year(), m)
+		if m < t.Month() {
+			elapsed += n
+		}
+		days += n
+	}
+	return 100 * (float64(elapsed+t.Day()-1) + fractionOfDay(t)) / float64(days)
+}
+
+// PercentOfYearElapsed returns how much of the year of `t` has passed.
+// See PercentOfMonthElapsed.
+func PercentOfYearElapsed(t time.Time) float64 {
+	return 100 * (float64(t.YearDay()-1) + fractionOfDay(t)) / float64(DaysInYear(t.Year()))
+}
+
+// fractionOfDay returns the part of the day elapsed on the wall clock of
+// `t`.
+func fractionOfDay(t time.Time) float64 {
+	h, m, s := t.Clock()
+	clock := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+	return float64(clock) / float64(24*time.Hour)
+}
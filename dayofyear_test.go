@@ -0,0 +1,83 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestDayOfYear(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		day       int
+		remaining int
+	}{
+		{date(2015, time.January, 1), 1, 364},
+		{date(2015, time.March, 1), 60, 305},
+		{date(2016, time.March, 1), 61, 305},
+		{date(2015, time.December, 31), 365, 0},
+		{time.Date(2016, time.December, 31, 23, 59, 59, 0, nyc), 366, 0},
+	}
+
+	for _, c := range cases {
+		if got := DayOfYear(c.t); got != c.day {
+			t.Errorf("DayOfYear(%v) == %d, want %d", c.t, got, c.day)
+		}
+		if got := DaysRemainingInYear(c.t); got != c.remaining {
+			t.Errorf("DaysRemainingInYear(%v) == %d, want %d", c.t, got, c.remaining)
+		}
+		y, m, d := c.t.Date()
+		if got, want := FromOrdinal(y, c.day, c.t.Location()), time.Date(y, m, d, 0, 0, 0, 0, c.t.Location()); got != want {
+			t.Errorf("FromOrdinal(%d, %d) == %v, want %v", y, c.day, got, want)
+		}
+	}
+}
+
+func TestFromOrdinal(t *testing.T) {
+	cases := []struct {
+		year, yday int
+		expected   time.Time
+	}{
+		{2016, 60, date(2016, time.February, 29)},
+		{2015, 60, date(2015, time.March, 1)},
+		{2015, 0, date(2014, time.December, 31)},
+		{2015, 366, date(2016, time.January, 1)},
+		{2016, 366, date(2016, time.December, 31)},
+	}
+
+	for _, c := range cases {
+		got := FromOrdinal(c.year, c.yday, utc)
+		if got != c.expected {
+			t.Errorf("FromOrdinal(%d, %d) == %v, want %v", c.year, c.yday, got, c.expected)
+		}
+	}
+}
+
+func TestPercentElapsed(t *testing.T) {
+	cases := []struct {
+		t                    time.Time
+		month, quarter, year float64
+	}{
+		{date(2015, time.January, 1), 0, 0, 0},
+		{time.Date(2015, time.February, 15, 0, 0, 0, 0, utc), 50, 45.0 / 90 * 100, 45.0 / 365 * 100},
+		{time.Date(2016, time.February, 15, 12, 0, 0, 0, utc), 14.5 / 29 * 100, 45.5 / 91 * 100, 45.5 / 366 * 100},
+		{time.Date(2015, time.December, 31, 18, 0, 0, 0, utc), 30.75 / 31 * 100, 91.75 / 92 * 100, 364.75 / 365 * 100},
+		// the wall clock is used across the DST change
+		{time.Date(2015, time.March, 8, 12, 0, 0, 0, nyc), 7.5 / 31 * 100, 66.5 / 90 * 100, 66.5 / 365 * 100},
+	}
+
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+	for _, c := range cases {
+		if got := PercentOfMonthElapsed(c.t); !near(got, c.month) {
+			t.Errorf("PercentOfMonthElapsed(%v) == %v, want %v", c.t, got, c.month)
+		}
+		if got := PercentOfQuarterElapsed(c.t); !near(got, c.quarter) {
+			t.Errorf("PercentOfQuarterElapsed(%v) == %v, want %v", c.t, got, c.quarter)
+		}
+		if got := PercentOfYearElapsed(c.t); !near(got, c.year) {
+			t.Errorf("PercentOfYearElapsed(%v) == %v, want %v", c.t, got, c.year)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package timex
+
+import (
+	"math"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Epoch is a timestamp counted from some fixed instant, as produced by
+// other systems. Each type in this package that implements it converts
+// to time.Time without loss; converting a time.Time to one of them is
+// limited by the precision and range of the type, as described on it.
+type Epoch interface {
+	Time() time.Time
+}
+
+// UnixMillis is a count of milliseconds since 1970-01-01 00:00:00 UTC,
+// as used by Java and JavaScript. Converting a time.Time drops the
+// sub-millisecond part, rounding toward the past. The range covers
+// about 292 million years either side of 1970, wider than time.Time
+// can represent.
+type UnixMillis int64
+
+// ToUnixMillis returns `t` as milliseconds since the Unix epoch.
+func ToUnixMillis(t time.Time) UnixMillis {
+	return UnixMillis(t.UnixMilli())
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (ms UnixMillis) Time() time.Time {
+	return time.UnixMilli(int64(ms)).UTC()
+}
+
+// UnixMicros is a count of microseconds since 1970-01-01 00:00:00 UTC,
+// as used by PostgreSQL and many tracing systems. Converting a time.Time
+// drops the sub-microsecond part, rounding toward the past. The range
+// is about 292,000 years either side of 1970; the result for a
+// time.Time outside of it is undefined.
+type UnixMicros int64
+
+// ToUnixMicros returns `t` as microseconds since the Unix epoch.
+func ToUnixMicros(t time.Time) UnixMicros {
+	return UnixMicros(t.UnixMicro())
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (us UnixMicros) Time() time.Time {
+	return time.UnixMicro(int64(us)).UTC()
+}
+
+// NTPTime is a 64-bit NTP timestamp: the high 32 bits are seconds since
+// 1900-01-01 00:00:00 UTC and the low 32 bits a binary fraction of a
+// second, a resolution of about 233 picoseconds, so a time.Time round
+// trips exactly.
+//
+// The seconds wrap every 2^32 seconds (about 136 years). Following RFC
+// 4330, Time reads timestamps with the high bit clear as being after
+// the wrap in 2036, so the usable range is 1968-01-20 03:14:08 UTC to
+// 2104-02-26 09:42:23 UTC. ToNTP silently wraps times outside of it.
+type NTPTime uint64
+
+const (
+	// ntpEpoch is 1900-01-01 00:00:00 UTC in Unix seconds.
+	ntpEpoch = -2208988800
+	// ntpEra1 is the start of the second NTP era, 2036-02-07 06:28:16
+	// UTC, in Unix seconds.
+	ntpEra1 = ntpEpoch + 1<<32
+)
+
+// ToNTP returns `t` as an NTP timestamp.
+func ToNTP(t time.Time) NTPTime {
+	secs := uint64(t.Unix()-ntpEpoch) & math.MaxUint32
+	frac := (uint64(t.Nanosecond())<<32 + 5e8) / 1e9
+	return NTPTime(secs<<32 | frac)
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (n NTPTime) Time() time.Time {
+	secs := int64(n >> 32)
+	if secs&(1<<31) == 0 {
+		secs += ntpEra1
+	} else {
+		secs += ntpEpoch
+	}
+	nanos := (uint64(n&math.MaxUint32)*1e9 + 1<<31) >> 32
+	return time.Unix(secs, int64(nanos)).UTC()
+}
+
+// GPSTime is a count of nanoseconds since the GPS epoch, 1980-01-06
+// 00:00:00 UTC. The count is of elapsed time.Time nanoseconds, which do
+// not include leap seconds, so it does not match a receiver's GPS time
+// scale, which runs ahead of UTC by the leap seconds since 1980. Use
+// ConvertTimeScale to move between the two. The range is about 292
+// years either side of 1980; ToGPSTime saturates outside of it.
+type GPSTime int64
+
+var gpsEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+const gpsWeek = 7 * 24 * time.Hour
+
+// ToGPSTime returns `t` as nanoseconds since the GPS epoch.
+func ToGPSTime(t time.Time) GPSTime {
+	return GPSTime(t.Sub(gpsEpoch))
+}
+
+// GPSFromWeek returns the GPSTime for a week number since the GPS epoch
+// and a time into that week. The week must not be truncated to the 10
+// or 13 bits of a broadcast week number.
+func GPSFromWeek(week int, tow time.Duration) GPSTime {
+	return GPSTime(time.Duration(week)*gpsWeek + tow)
+}
+
+// Week returns the number of weeks since the GPS epoch.
+func (g GPSTime) Week() int {
+	return int(calendrical.FloorDiv(int64(g), int64(gpsWeek)))
+}
+
+// TimeOfWeek returns the time elapsed since the start of the week.
+func (g GPSTime) TimeOfWeek() time.Duration {
+	return time.Duration(int64(g) - int64(g.Week())*int64(gpsWeek))
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (g GPSTime) Time() time.Time {
+	return gpsEpoch.Add(time.Duration(g))
+}
+
+// FileTime is a Windows FILETIME: a count of 100 nanosecond intervals
+// since 1601-01-01 00:00:00 UTC. Converting a time.Time drops the
+// nanoseconds below 100, rounding toward the past. ToFileTime saturates
+// at 0 for times before 1601 and at the maximum value, in the year
+// 60056, for later times.
+type FileTime uint64
+
+// fileTimeEpoch is 1601-01-01 00:00:00 UTC in Unix seconds.
+const fileTimeEpoch = -11644473600
+
+// ToFileTime returns `t` as a Windows FILETIME.
+func ToFileTime(t time.Time) FileTime {
+	if t.Unix() < fileTimeEpoch {
+		return 0
+	}
+	if max := FileTime(math.MaxUint64).Time(); t.After(max) {
+		return math.MaxUint64
+	}
+	return FileTime(uint64(t.Unix()-fileTimeEpoch)*1e7 + uint64(t.Nanosecond())/100)
+}
+
+// FileTimeFromParts returns the FileTime stored as the two 32-bit words
+// of a Windows FILETIME structure.
+func FileTimeFromParts(low, high uint32) FileTime {
+	return FileTime(uint64(high)<<32 | uint64(low))
+}
+
+// Parts returns the two 32-bit words of a Windows FILETIME structure.
+func (ft FileTime) Parts() (low, high uint32) {
+	return uint32(ft), uint32(ft >> 32)
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (ft FileTime) Time() time.Time {
+	secs := int64(ft/1e7) + fileTimeEpoch
+	nanos := int64(ft%1e7) * 100
+	return time.Unix(secs, nanos).UTC()
+}
+
+// DotNetTicks is the Ticks of a .NET DateTime: a count of 100
+// nanosecond intervals since 0001-01-01 00:00:00. Converting a time.Time
+// drops the nanoseconds below 100, rounding toward the past.
+// ToDotNetTicks saturates at DateTime.MinValue and DateTime.MaxValue,
+// the end of the year 9999. The ticks are taken as UTC.
+type DotNetTicks int64
+
+const (
+	// dotNetEpoch is 0001-01-01 00:00:00 UTC in Unix seconds.
+	dotNetEpoch = -62135596800
+	// maxDotNetTicks is DateTime.MaxValue.Ticks.
+	maxDotNetTicks = 3155378975999999999
+)
+
+// ToDotNetTicks returns `t` as .NET DateTime ticks.
+func ToDotNetTicks(t time.Time) DotNetTicks {
+	if t.Unix() < dotNetEpoch {
+		return 0
+	}
+	if max := DotNetTicks(maxDotNetTicks).Time(); t.After(max) {
+		return maxDotNetTicks
+	}
+	return DotNetTicks((t.Unix()-dotNetEpoch)*1e7 + int64(t.Nanosecond())/100)
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (dt DotNetTicks) Time() time.Time {
+	secs := calendrical.FloorDiv(int64(dt), 1e7)
+	nanos := (int64(dt) - secs*1e7) * 100
+	return time.Unix(secs+dotNetEpoch, nanos).UTC()
+}
+
+// CocoaTime is a count of seconds since 2001-01-01 00:00:00 UTC, the
+// reference date of NSDate, CFAbsoluteTime and Core Data. The float64
+// keeps about 16 significant digits, so times within a few decades of
+// 2001 round trip to within 100 nanoseconds and the precision falls as
+// times move further away. Time rounds to the nearest nanosecond.
+type CocoaTime float64
+
+// cocoaEpoch is 2001-01-01 00:00:00 UTC in Unix seconds.
+const cocoaEpoch = 978307200
+
+// ToCocoa returns `t` as seconds since the Cocoa reference date.
+func ToCocoa(t time.Time) CocoaTime {
+	return CocoaTime(float64(t.Unix()-cocoaEpoch) + float64(t.Nanosecond())/1e9)
+}
+
+// Time returns the timestamp as a time.Time in UTC.
+func (c CocoaTime) Time() time.Time {
+	secs := math.Floor(float64(c))
+	nanos := math.Round((float64(c) - secs) * 1e9)
+	return time.Unix(int64(secs)+cocoaEpoch, int64(nanos)).UTC()
+}
@@ -0,0 +1,128 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+func TestEpochValues(t *testing.T) {
+	cases := []struct {
+		name     string
+		got      Epoch
+		expected Epoch
+	}{
+		{"ToUnixMillis", ToUnixMillis(time.Unix(1, 999999999)), UnixMillis(1999)},
+		{"ToUnixMillis", ToUnixMillis(time.Unix(-1, 1)), UnixMillis(-1000)},
+		{"ToUnixMicros", ToUnixMicros(time.Unix(1, 999999999)), UnixMicros(1999999)},
+		{"ToNTP", ToNTP(unixEpoch), NTPTime(0x83AA7E80 << 32)},
+		{"ToNTP", ToNTP(time.Unix(0, 5e8)), NTPTime(0x83AA7E80<<32 | 0x80000000)},
+		{"ToNTP", ToNTP(time.Date(2036, time.February, 7, 6, 28, 16, 0, utc)), NTPTime(0)},
+		{"ToGPSTime", ToGPSTime(time.Date(1980, time.January, 6, 0, 0, 0, 0, utc)), GPSTime(0)},
+		{"ToGPSTime", ToGPSTime(date(2015, time.July, 1)), GPSFromWeek(1851, 3*24*time.Hour)},
+		{"ToGPSTime", ToGPSTime(date(2500, time.July, 1)), GPSTime(math.MaxInt64)},
+		{"ToFileTime", ToFileTime(unixEpoch), FileTime(116444736000000000)},
+		{"ToFileTime", ToFileTime(time.Unix(0, 199)), FileTime(116444736000000001)},
+		{"ToFileTime", ToFileTime(date(1600, time.December, 31)), FileTime(0)},
+		{"ToFileTime", ToFileTime(date(70000, time.January, 1)), FileTime(math.MaxUint64)},
+		{"ToDotNetTicks", ToDotNetTicks(unixEpoch), DotNetTicks(621355968000000000)},
+		{"ToDotNetTicks", ToDotNetTicks(date(1, time.January, 1)), DotNetTicks(0)},
+		{"ToDotNetTicks", ToDotNetTicks(date(0, time.December, 31)), DotNetTicks(0)},
+		{"ToDotNetTicks", ToDotNetTicks(date(10000, time.January, 1)), DotNetTicks(3155378975999999999)},
+		{"ToCocoa", ToCocoa(date(2001, time.January, 1)), CocoaTime(0)},
+		{"ToCocoa", ToCocoa(unixEpoch), CocoaTime(-978307200)},
+		{"ToCocoa", ToCocoa(time.Date(2001, time.January, 1, 0, 0, 1, 5e8, utc)), CocoaTime(1.5)},
+	}
+
+	for _, c := range cases {
+		if c.got != c.expected {
+			t.Errorf("%s == %v, want %v", c.name, c.got, c.expected)
+		}
+	}
+}
+
+func TestEpochTime(t *testing.T) {
+	cases := []struct {
+		e        Epoch
+		expected time.Time
+	}{
+		{UnixMillis(-1), time.Unix(0, -1e6).UTC()},
+		{UnixMicros(1), time.Unix(0, 1e3).UTC()},
+		{NTPTime(0x83AA7E80 << 32), unixEpoch},
+		{NTPTime(0), time.Date(2036, time.February, 7, 6, 28, 16, 0, utc)},
+		{NTPTime(0x80000000 << 32), time.Date(1968, time.January, 20, 3, 14, 8, 0, utc)},
+		{NTPTime(0xFFFFFFFF << 32), time.Date(2036, time.February, 7, 6, 28, 15, 0, utc)},
+		{GPSFromWeek(0, 0), time.Date(1980, time.January, 6, 0, 0, 0, 0, utc)},
+		{FileTime(0), date(1601, time.January, 1)},
+		{FileTimeFromParts(0xD53E8000, 0x019DB1DE), unixEpoch},
+		{DotNetTicks(0), date(1, time.January, 1)},
+		{DotNetTicks(3155378975999999999), time.Date(9999, time.December, 31, 23, 59, 59, 999999900, utc)},
+		{CocoaTime(-0.25), time.Date(2000, time.December, 31, 23, 59, 59, 75e7, utc)},
+	}
+
+	for _, c := range cases {
+		got := c.e.Time()
+		if got != c.expected {
+			t.Errorf("%T(%v).Time() == %v, want %v", c.e, c.e, got, c.expected)
+		}
+	}
+}
+
+func TestEpochRoundTrip(t *testing.T) {
+	times := []time.Time{
+		time.Date(2015, time.July, 1, 12, 30, 45, 123456789, utc),
+		time.Date(1999, time.December, 31, 23, 59, 59, 999999999, utc),
+		time.Date(2036, time.February, 7, 6, 28, 16, 1, utc),
+		time.Date(1970, time.January, 1, 0, 0, 0, 1, utc),
+	}
+
+	for _, tm := range times {
+		if got := ToNTP(tm).Time(); got != tm {
+			t.Errorf("ToNTP(%v).Time() == %v", tm, got)
+		}
+		if got := ToGPSTime(tm).Time(); got != tm {
+			t.Errorf("ToGPSTime(%v).Time() == %v", tm, got)
+		}
+		if got, want := ToFileTime(tm).Time(), tm.Truncate(100); got != want {
+			t.Errorf("ToFileTime(%v).Time() == %v, want %v", tm, got, want)
+		}
+		if got, want := ToDotNetTicks(tm).Time(), tm.Truncate(100); got != want {
+			t.Errorf("ToDotNetTicks(%v).Time() == %v, want %v", tm, got, want)
+		}
+		if got, want := ToUnixMillis(tm).Time(), tm.Truncate(time.Millisecond); got != want {
+			t.Errorf("ToUnixMillis(%v).Time() == %v, want %v", tm, got, want)
+		}
+		if got, want := ToUnixMicros(tm).Time(), tm.Truncate(time.Microsecond); got != want {
+			t.Errorf("ToUnixMicros(%v).Time() == %v, want %v", tm, got, want)
+		}
+		if got := ToCocoa(tm).Time(); got.Sub(tm) > 100 || tm.Sub(got) > 100 {
+			t.Errorf("ToCocoa(%v).Time() == %v", tm, got)
+		}
+	}
+
+	low, high := ToFileTime(unixEpoch).Parts()
+	if low != 0xD53E8000 || high != 0x019DB1DE {
+		t.Errorf("FileTime.Parts() == %#x, %#x, want 0xd53e8000, 0x19db1de", low, high)
+	}
+}
+
+func TestGPSWeek(t *testing.T) {
+	cases := []struct {
+		g    GPSTime
+		week int
+		tow  time.Duration
+	}{
+		{ToGPSTime(time.Date(2015, time.July, 1, 6, 0, 0, 0, utc)), 1851, 3*24*time.Hour + 6*time.Hour},
+		{ToGPSTime(time.Date(1980, time.January, 5, 0, 0, 0, 0, utc)), -1, 6 * 24 * time.Hour},
+	}
+
+	for _, c := range cases {
+		if week, tow := c.g.Week(), c.g.TimeOfWeek(); week != c.week || tow != c.tow {
+			t.Errorf("GPSTime(%d) week == %d, %v, want %d, %v", c.g, week, tow, c.week, c.tow)
+		}
+	}
+}
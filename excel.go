@@ -0,0 +1,86 @@
+package timex
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ExcelDateSystem is the epoch a spreadsheet counts its serial dates
+// from.
+type ExcelDateSystem int
+
+const (
+	// Excel1900 is the default system of Excel on Windows and of
+	// LibreOffice. Serial 1 is January 1st, 1900. For compatibility with
+	// Lotus 1-2-3 it counts a February 29th, 1900 that never happened as
+	// serial 60, so every date from March 1st, 1900 is one higher than
+	// the days elapsed.
+	Excel1900 ExcelDateSystem = iota
+	// Excel1904 is the system of older Excel for Mac workbooks. Serial 0
+	// is January 1st, 1904.
+	Excel1904
+)
+
+var (
+	excel1900Epoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	excel1904Epoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// FromExcelSerial returns the time for a spreadsheet serial date. The
+// whole part of `serial` is the date and the fraction is the time of
+// day, rounded to the microsecond. Spreadsheets store wall clock times
+// without a zone, so the result is that wall clock in `loc`.
+//
+// An error is returned for negative serials and, in the 1900 system,
+// for serial 60, the nonexistent February 29th, 1900.
+func FromExcelSerial(serial float64, system ExcelDateSystem, loc *time.Location) (time.Time, error) {
+	if serial < 0 || math.IsNaN(serial) || math.IsInf(serial, 0) {
+		return time.Time{}, fmt.Errorf("timex: invalid spreadsheet serial date %v", serial)
+	}
+
+	days := math.Floor(serial)
+	micros := math.Round((serial - days) * secondsPerDay * 1e6)
+
+	var epoch time.Time
+	switch system {
+	case Excel1900:
+		if days == 60 {
+			return time.Time{}, fmt.Errorf("timex: spreadsheet serial date %v is February 29th, 1900, which does not exist", serial)
+		}
+		if days < 60 {
+			days++
+		}
+		epoch = excel1900Epoch
+	case Excel1904:
+		epoch = excel1904Epoch
+	default:
+		return time.Time{}, fmt.Errorf("timex: unknown spreadsheet date system %d", system)
+	}
+
+	y, m, d := epoch.AddDate(0, 0, int(days)).Date()
+	return time.Date(y, m, d, 0, 0, 0, int(micros)*int(time.Microsecond), loc), nil
+}
+
+// ToExcelSerial returns the spreadsheet serial date for the wall clock
+// of `t`. An error is returned for dates before the first serial of the
+// system.
+func ToExcelSerial(t time.Time, system ExcelDateSystem) (float64, error) {
+	var days int
+	switch system {
+	case Excel1900:
+		days = daysBetweenDates(excel1900Epoch, t)
+		if days <= 60 {
+			days--
+		}
+	case Excel1904:
+		days = daysBetweenDates(excel1904Epoch, t)
+	default:
+		return 0, fmt.Errorf("timex: unknown spreadsheet date system %d", system)
+	}
+	if days < 0 {
+		return 0, fmt.Errorf("timex: %v is before the first spreadsheet serial date", t)
+	}
+
+	return float64(days) + fractionOfDay(t), nil
+}
@@ -0,0 +1,111 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestFromExcelSerial(t *testing.T) {
+	cases := []struct {
+		serial   float64
+		system   ExcelDateSystem
+		loc      *time.Location
+		expected time.Time
+	}{
+		{0, Excel1900, utc, date(1899, time.December, 31)},
+		{1, Excel1900, utc, date(1900, time.January, 1)},
+		{59, Excel1900, utc, date(1900, time.February, 28)},
+		{61, Excel1900, utc, date(1900, time.March, 1)},
+		{42186, Excel1900, utc, date(2015, time.July, 1)},
+		{42186.75, Excel1900, utc, time.Date(2015, time.July, 1, 18, 0, 0, 0, utc)},
+		{42186.5, Excel1900, nyc, time.Date(2015, time.July, 1, 12, 0, 0, 0, nyc)},
+		// 1 second before midnight survives floating point error
+		{42186 + 86399.0/86400, Excel1900, utc, time.Date(2015, time.July, 1, 23, 59, 59, 0, utc)},
+		{0, Excel1904, utc, date(1904, time.January, 1)},
+		{40724, Excel1904, utc, date(2015, time.July, 1)},
+		{40724.25, Excel1904, utc, time.Date(2015, time.July, 1, 6, 0, 0, 0, utc)},
+	}
+
+	for _, c := range cases {
+		got, err := FromExcelSerial(c.serial, c.system, c.loc)
+		if err != nil || got != c.expected {
+			t.Errorf("FromExcelSerial(%v, %d, %v) == %v, %v, want %v", c.serial, c.system, c.loc, got, err, c.expected)
+		}
+	}
+
+	errors := []struct {
+		serial float64
+		system ExcelDateSystem
+	}{
+		{60, Excel1900},
+		{60.5, Excel1900},
+		{-1, Excel1900},
+		{-1, Excel1904},
+		{math.NaN(), Excel1900},
+		{1, ExcelDateSystem(7)},
+	}
+	for _, c := range errors {
+		if got, err := FromExcelSerial(c.serial, c.system, utc); err == nil {
+			t.Errorf("FromExcelSerial(%v, %d) == %v, want an error", c.serial, c.system, got)
+		}
+	}
+}
+
+func TestToExcelSerial(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		system   ExcelDateSystem
+		expected float64
+	}{
+		{date(1899, time.December, 31), Excel1900, 0},
+		{date(1900, time.January, 1), Excel1900, 1},
+		{date(1900, time.February, 28), Excel1900, 59},
+		{date(1900, time.March, 1), Excel1900, 61},
+		{date(2015, time.July, 1), Excel1900, 42186},
+		{time.Date(2015, time.July, 1, 18, 0, 0, 0, utc), Excel1900, 42186.75},
+		// the wall clock is used, not the instant
+		{time.Date(2015, time.July, 1, 18, 0, 0, 0, nyc), Excel1900, 42186.75},
+		{time.Date(2015, time.November, 1, 12, 0, 0, 0, nyc), Excel1900, 42309.5},
+		{date(1904, time.January, 1), Excel1904, 0},
+		{time.Date(2015, time.July, 1, 6, 0, 0, 0, utc), Excel1904, 40724.25},
+	}
+
+	for _, c := range cases {
+		got, err := ToExcelSerial(c.t, c.system)
+		if err != nil || got != c.expected {
+			t.Errorf("ToExcelSerial(%v, %d) == %v, %v, want %v", c.t, c.system, got, err, c.expected)
+		}
+	}
+
+	errors := []struct {
+		t      time.Time
+		system ExcelDateSystem
+	}{
+		{date(1899, time.December, 30), Excel1900},
+		{date(1903, time.December, 31), Excel1904},
+		{date(2015, time.July, 1), ExcelDateSystem(7)},
+	}
+	for _, c := range errors {
+		if got, err := ToExcelSerial(c.t, c.system); err == nil {
+			t.Errorf("ToExcelSerial(%v, %d) == %v, want an error", c.t, c.system, got)
+		}
+	}
+}
+
+func TestExcelSerialRoundTrip(t *testing.T) {
+	for _, system := range []ExcelDateSystem{Excel1900, Excel1904} {
+		for d := date(1904, time.January, 1); d.Year() < 1910; d = d.Add(7*time.Hour + 13*time.Minute + 500*time.Millisecond) {
+			serial, err := ToExcelSerial(d, system)
+			if err != nil {
+				t.Fatalf("ToExcelSerial(%v, %d) returned error %v", d, system, err)
+			}
+			got, err := FromExcelSerial(serial, system, utc)
+			if err != nil || got != d {
+				t.Fatalf("FromExcelSerial(ToExcelSerial(%v, %d)) == %v, %v", d, system, got, err)
+			}
+		}
+	}
+}
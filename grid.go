@@ -0,0 +1,188 @@
+package timex
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// GridDay is a cell of a MonthGrid.
+type GridDay struct {
+	Date    time.Time
+	InMonth bool // whether Date is in the month of the grid
+}
+
+// MonthGrid returns the days of `month` laid out as a wall calendar: 6
+// weeks of 7 days, each week beginning on `weekStart`. The cells before
+// the 1st and after the last day of the month hold the days of the
+// neighbouring months. Dates are at midnight UTC.
+func MonthGrid(year int, month time.Month, weekStart time.Weekday) [6][7]GridDay {
+	first := FirstDayOfMonth(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+	lead := DaysBetweenWeekdays(weekStart, first.Weekday())
+	n := DaysInMonth(first.Year(), first.Month())
+
+	var grid [6][7]GridDay
+	for i := 0; i < 42; i++ {
+		day := i - lead
+		grid[i/7][i%7] = GridDay{
+			Date:    first.AddDate(0, 0, day),
+			InMonth: day >= 0 && day < n,
+		}
+	}
+	return grid
+}
+
+// calWidth is the width of a month in FormatMonth, seven days of two
+// columns separated by spaces.
+const calWidth = 20
+
+// FormatMonth renders `month` in the style of cal(1): the month and year
+// centered over the weekdays and one line per week that has days of the
+// month. Weeks begin on the FirstDayOfWeek of `l`, and the names are
+// taken from `l`, or from EnglishUS when `l` is nil. Lines have no
+// trailing spaces.
+func FormatMonth(year int, month time.Month, l *Locale) string {
+	if l == nil {
+		l = EnglishUS
+	}
+	lines := monthLines(year, month, l, true)
+	var b strings.Builder
+	for _, line := range lines {
+		if line = strings.TrimRight(line, " "); line == "" {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// FormatYear renders the months of `year` in the style of `cal -y`,
+// three months to a row under the year. See FormatMonth.
+func FormatYear(year int, l *Locale) string {
+	if l == nil {
+		l = EnglishUS
+	}
+	const gap = "  "
+	width := 3*calWidth + 2*len(gap)
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(center(strconv.Itoa(year), width), " "))
+	b.WriteString("\n\n")
+	for m := time.January; m <= time.December; m += 3 {
+		rows := [3][]string{
+			monthLines(year, m, l, false),
+			monthLines(year, m+1, l, false),
+			monthLines(year, m+2, l, false),
+		}
+		for i := range rows[0] {
+			line := rows[0][i] + gap + rows[1][i] + gap + rows[2][i]
+			b.WriteString(strings.TrimRight(line, " "))
+			b.WriteByte('\n')
+		}
+		if m < time.October {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// monthLines returns the title, weekday names and 6 weeks of a month,
+// each padded to calWidth. The title has the year when `withYear` is
+// set.
+func monthLines(year int, month time.Month, l *Locale, withYear bool) []string {
+	title := l.MonthName(month)
+	if withYear {
+		title += " " + strconv.Itoa(year)
+	}
+	lines := []string{center(title, calWidth)}
+
+	grid := MonthGrid(year, month, l.FirstDayOfWeek)
+	names := make([]string, 7)
+	for i, gd := range grid[0] {
+		names[i] = pad(l.NarrowWeekdayName(gd.Date.Weekday()), 2)
+	}
+	lines = append(lines, strings.Join(names, " "))
+
+	for _, week := range grid {
+		days := make([]string, 7)
+		for i, gd := range week {
+			days[i] = "  "
+			if gd.InMonth {
+				days[i] = pad(strconv.Itoa(gd.Date.Day()), -2)
+			}
+		}
+		lines = append(lines, strings.Join(days, " "))
+	}
+	return lines
+}
+
+// center returns `s` centered in `width` columns, extra space going to
+// the right.
+func center(s string, width int) string {
+	left := (width - displayWidth(s)) / 2
+	if left < 0 {
+		left = 0
+	}
+	return pad(strings.Repeat(" ", left)+s, width)
+}
+
+// pad pads `s` with spaces to `width` columns, on the left if `width`
+// is negative.
+func pad(s string, width int) string {
+	right := width >= 0
+	if !right {
+		width = -width
+	}
+	n := width - displayWidth(s)
+	if n <= 0 {
+		return s
+	}
+	if right {
+		return s + strings.Repeat(" ", n)
+	}
+	return strings.Repeat(" ", n) + s
+}
+
+// displayWidth returns the number of terminal columns `s` takes up.
+// East Asian wide characters take two columns and combining marks none.
+func displayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case isWide(r):
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// wideRanges are the East Asian Wide and Fullwidth blocks of Unicode.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115f}, // Hangul Jamo initial consonants
+	{0x2e80, 0x303e}, // CJK radicals to CJK symbols and punctuation
+	{0x3041, 0x33ff}, // Hiragana to CJK compatibility
+	{0x3400, 0x4dbf}, // CJK unified ideographs extension A
+	{0x4e00, 0x9fff}, // CJK unified ideographs
+	{0xa000, 0xa4cf}, // Yi
+	{0xac00, 0xd7a3}, // Hangul syllables
+	{0xf900, 0xfaff}, // CJK compatibility ideographs
+	{0xfe30, 0xfe4f}, // CJK compatibility forms
+	{0xff00, 0xff60}, // fullwidth forms
+	{0xffe0, 0xffe6}, // fullwidth signs
+	{0x20000, 0x2fffd},
+	{0x30000, 0x3fffd},
+}
+
+func isWide(r rune) bool {
+	for _, wr := range wideRanges {
+		if wr[0] <= r && r <= wr[1] {
+			return true
+		}
+	}
+	return false
+}
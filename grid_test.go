@@ -0,0 +1,144 @@
+package timex_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestMonthGrid(t *testing.T) {
+	cases := []struct {
+		year      int
+		month     time.Month
+		weekStart time.Weekday
+		first     time.Time
+		last      time.Time
+		inMonth   int
+	}{
+		{2015, time.March, time.Sunday, date(2015, time.March, 1), date(2015, time.April, 11), 31},
+		{2015, time.March, time.Monday, date(2015, time.February, 23), date(2015, time.April, 5), 31},
+		{2015, time.February, time.Sunday, date(2015, time.February, 1), date(2015, time.March, 14), 28},
+		{2016, time.February, time.Monday, date(2016, time.February, 1), date(2016, time.March, 13), 29},
+		{2015, time.August, time.Sunday, date(2015, time.July, 26), date(2015, time.September, 5), 31},
+	}
+
+	for _, c := range cases {
+		grid := MonthGrid(c.year, c.month, c.weekStart)
+		if got := grid[0][0].Date; got != c.first {
+			t.Errorf("MonthGrid(%d, %v, %v) starts on %v, want %v", c.year, c.month, c.weekStart, got, c.first)
+		}
+		if got := grid[5][6].Date; got != c.last {
+			t.Errorf("MonthGrid(%d, %v, %v) ends on %v, want %v", c.year, c.month, c.weekStart, got, c.last)
+		}
+
+		inMonth := 0
+		for _, week := range grid {
+			for _, gd := range week {
+				if gd.InMonth != (gd.Date.Month() == c.month) {
+					t.Errorf("MonthGrid(%d, %v, %v) has InMonth %v for %v", c.year, c.month, c.weekStart, gd.InMonth, gd.Date)
+				}
+				if gd.InMonth {
+					inMonth++
+				}
+			}
+		}
+		if inMonth != c.inMonth {
+			t.Errorf("MonthGrid(%d, %v, %v) has %d days in the month, want %d", c.year, c.month, c.weekStart, inMonth, c.inMonth)
+		}
+	}
+}
+
+func TestFormatMonth(t *testing.T) {
+	cases := []struct {
+		year     int
+		month    time.Month
+		l        *Locale
+		expected string
+	}{
+		{2015, time.March, EnglishUS, `
+     March 2015
+Su Mo Tu We Th Fr Sa
+ 1  2  3  4  5  6  7
+ 8  9 10 11 12 13 14
+15 16 17 18 19 20 21
+22 23 24 25 26 27 28
+29 30 31
+`},
+		{2015, time.February, EnglishGB, `
+   February 2015
+Mo Tu We Th Fr Sa Su
+                   1
+ 2  3  4  5  6  7  8
+ 9 10 11 12 13 14 15
+16 17 18 19 20 21 22
+23 24 25 26 27 28
+`},
+		{2026, time.February, nil, `
+   February 2026
+Su Mo Tu We Th Fr Sa
+ 1  2  3  4  5  6  7
+ 8  9 10 11 12 13 14
+15 16 17 18 19 20 21
+22 23 24 25 26 27 28
+`},
+		// wide characters take two columns
+		{2026, time.February, Japanese, `
+      2月 2026
+日 月 火 水 木 金 土
+ 1  2  3  4  5  6  7
+ 8  9 10 11 12 13 14
+15 16 17 18 19 20 21
+22 23 24 25 26 27 28
+`},
+		{2026, time.February, Arabic, `
+    فبراير 2026
+س  ح  ن  ث  ر  خ  ج
+    1  2  3  4  5  6
+ 7  8  9 10 11 12 13
+14 15 16 17 18 19 20
+21 22 23 24 25 26 27
+28
+`},
+	}
+
+	for _, c := range cases {
+		got := FormatMonth(c.year, c.month, c.l)
+		if expected := strings.TrimPrefix(c.expected, "\n"); got != expected {
+			t.Errorf("FormatMonth(%d, %v, %v) ==\n%s\nwant\n%s", c.year, c.month, c.l, got, expected)
+		}
+	}
+}
+
+func TestFormatYear(t *testing.T) {
+	got := FormatYear(2015, EnglishUS)
+	lines := strings.Split(got, "\n")
+
+	// the year, a blank line, then 4 rows of 8 lines separated by blank
+	// lines, and the final newline
+	if len(lines) != 2+4*8+3+1 {
+		t.Fatalf("FormatYear(2015) has %d lines, want %d:\n%s", len(lines), 2+4*8+3+1, got)
+	}
+
+	expected := []string{
+		"                              2015",
+		"",
+		"      January               February               March",
+		"Su Mo Tu We Th Fr Sa  Su Mo Tu We Th Fr Sa  Su Mo Tu We Th Fr Sa",
+		"             1  2  3   1  2  3  4  5  6  7   1  2  3  4  5  6  7",
+		" 4  5  6  7  8  9 10   8  9 10 11 12 13 14   8  9 10 11 12 13 14",
+	}
+	for i, want := range expected {
+		if lines[i] != want {
+			t.Errorf("FormatYear(2015) line %d == %q, want %q", i, lines[i], want)
+		}
+	}
+	if want := "      October               November              December"; lines[29] != want {
+		t.Errorf("FormatYear(2015) line 29 == %q, want %q", lines[29], want)
+	}
+
+	if got := FormatYear(2015, nil); got != FormatYear(2015, EnglishUS) {
+		t.Errorf("FormatYear(2015, nil) ==\n%s\nwant\n%s", got, FormatYear(2015, EnglishUS))
+	}
+}
@@ -0,0 +1,56 @@
+package hebrew
+
+import (
+	"time"
+
+	"github.com/justrudd/timex"
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Calendar is the Hebrew calendar as a timex.Calendar, for use with its
+// generic adjusters. Months are numbered in the order of the year from
+// Tishrei, so Nisan is month 7 in a common year and month 8 in a leap
+// year.
+var Calendar timex.Calendar = calendar{}
+
+type calendar struct{}
+
+func (calendar) Date(t time.Time) (int, time.Month, int) {
+	d := FromTime(t)
+	return d.Year, time.Month(ordinal(d.Year, d.Month)), d.Day
+}
+
+func (calendar) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	n := Date{year, fromOrdinal(year, int(month)), 1}.jdn()
+	return calendrical.FromJDN(n+int64(day-1), loc)
+}
+
+func (calendar) IsLeapYear(year int) bool {
+	return IsLeapYear(year)
+}
+
+func (calendar) MonthsInYear(year int) int {
+	return MonthsInYear(year)
+}
+
+func (calendar) DaysInMonth(year int, month time.Month) int {
+	return DaysInMonth(year, fromOrdinal(year, int(month)))
+}
+
+func (calendar) String() string { return "Hebrew" }
+
+// ordinal returns the position of `m` in `year`, counting from Tishrei.
+func ordinal(year int, m Month) int {
+	if m >= Tishrei {
+		return int(m - Elul)
+	}
+	return int(m + lastMonth(year) - Elul)
+}
+
+// fromOrdinal returns the month at position `k` of `year`.
+func fromOrdinal(year, k int) Month {
+	if n := int(lastMonth(year) - Elul); k > n {
+		return Month(k - n)
+	}
+	return Month(k) + Elul
+}
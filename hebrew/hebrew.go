@@ -0,0 +1,227 @@
+// Package hebrew converts between time.Time and dates of the Hebrew
+// calendar and computes the dates of the major Jewish holidays.
+//
+// The calendar is the fixed arithmetic calendar of Hillel II, including
+// the dehiyyot, the rules that postpone Rosh Hashanah so that it never
+// falls on a Sunday, Wednesday or Friday and that keep every year
+// between 353 and 385 days long.
+//
+// A Hebrew day begins at sunset. This package converts the civil date of
+// a time.Time, so the evening before a date belongs to it in the
+// Hebrew reckoning but not here.
+package hebrew
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Month is a month of the Hebrew calendar, numbered from Nisan as in the
+// Torah. The year begins with Tishrei. In leap years Adar is Adar I and
+// is followed by AdarII.
+type Month int
+
+// The months of the Hebrew calendar.
+const (
+	Nisan Month = 1 + iota
+	Iyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishrei
+	Cheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+var monthNames = [...]string{
+	Nisan:    "Nisan",
+	Iyar:     "Iyar",
+	Sivan:    "Sivan",
+	Tammuz:   "Tammuz",
+	Av:       "Av",
+	Elul:     "Elul",
+	Tishrei:  "Tishrei",
+	Cheshvan: "Cheshvan",
+	Kislev:   "Kislev",
+	Tevet:    "Tevet",
+	Shevat:   "Shevat",
+	Adar:     "Adar",
+	AdarII:   "Adar II",
+}
+
+func (m Month) String() string {
+	if m < Nisan || m > AdarII {
+		return "Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return monthNames[m]
+}
+
+// Date is a date in the Hebrew calendar. Years are counted from the
+// creation, anno mundi; 5776 began in September 2015.
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// FromTime returns the Hebrew date of the civil date of `t`.
+func FromTime(t time.Time) Date {
+	return fromJDN(calendrical.JDN(t))
+}
+
+// Time returns a new time.Time at midnight in `loc` on the civil date
+// that corresponds to the Hebrew date. An error is returned for months
+// and days that do not exist in the year.
+func (d Date) Time(loc *time.Location) (time.Time, error) {
+	if !d.IsValid() {
+		return time.Time{}, fmt.Errorf("hebrew: invalid date %v", d)
+	}
+	return calendrical.FromJDN(d.jdn(), loc), nil
+}
+
+// IsValid returns whether the month and day exist in the year.
+func (d Date) IsValid() bool {
+	if d.Month < Nisan || d.Month > AdarII || (d.Month == AdarII && !IsLeapYear(d.Year)) {
+		return false
+	}
+	return d.Day >= 1 && d.Day <= DaysInMonth(d.Year, d.Month)
+}
+
+// String returns the date as in "15 Nisan 5775". Adar of a leap year is
+// written "Adar I".
+func (d Date) String() string {
+	name := d.Month.String()
+	if d.Month == Adar && IsLeapYear(d.Year) {
+		name = "Adar I"
+	}
+	return strconv.Itoa(d.Day) + " " + name + " " + strconv.Itoa(d.Year)
+}
+
+// IsLeapYear returns whether `year` has 13 months. Leap years are years
+// 3, 6, 8, 11, 14, 17 and 19 of the 19 year Metonic cycle.
+func IsLeapYear(year int) bool {
+	return calendrical.Mod(7*year+1, 19) < 7
+}
+
+// MonthsInYear returns 13 for leap years and 12 otherwise.
+func MonthsInYear(year int) int {
+	if IsLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// DaysInYear returns the number of days in `year`: 353, 354 or 355 in
+// common years and 383, 384 or 385 in leap years.
+func DaysInYear(year int) int {
+	return int(newYear(year+1) - newYear(year))
+}
+
+// DaysInMonth returns the number of days in month `m` of `year`. Cheshvan
+// and Kislev vary with the length of the year, and Adar has 30 days in
+// leap years, when it is Adar I.
+func DaysInMonth(year int, m Month) int {
+	switch m {
+	case Iyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !IsLeapYear(year) {
+			return 29
+		}
+	case Cheshvan:
+		if n := DaysInYear(year); n != 355 && n != 385 {
+			return 29
+		}
+	case Kislev:
+		if n := DaysInYear(year); n == 353 || n == 383 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// epoch is the Julian Day Number of 1 Tishrei 1.
+const epoch = 347998
+
+// elapsedDays returns the days from the epoch to the molad of Tishrei of
+// `year`, moved to the next day when the molad falls in the afternoon or
+// Rosh Hashanah would fall on a Sunday, Wednesday or Friday.
+func elapsedDays(year int) int64 {
+	months := calendrical.FloorDiv(235*int64(year)-234, 19)
+	parts := 12084 + 13753*months
+	day := 29*months + calendrical.FloorDiv(parts, 25920)
+	if calendrical.Mod64(3*(day+1), 7) < 3 {
+		day++
+	}
+	return day
+}
+
+// newYear returns the Julian Day Number of 1 Tishrei of `year`, applying
+// the postponements that keep the years at valid lengths.
+func newYear(year int) int64 {
+	ny0, ny1, ny2 := elapsedDays(year-1), elapsedDays(year), elapsedDays(year+1)
+	correction := int64(0)
+	if ny2-ny1 == 356 {
+		correction = 2
+	} else if ny1-ny0 == 382 {
+		correction = 1
+	}
+	return epoch + ny1 + correction
+}
+
+func (d Date) jdn() int64 {
+	n := newYear(d.Year) + int64(d.Day) - 1
+	if d.Month < Tishrei {
+		for m := Tishrei; m <= lastMonth(d.Year); m++ {
+			n += int64(DaysInMonth(d.Year, m))
+		}
+		for m := Nisan; m < d.Month; m++ {
+			n += int64(DaysInMonth(d.Year, m))
+		}
+	} else {
+		for m := Tishrei; m < d.Month; m++ {
+			n += int64(DaysInMonth(d.Year, m))
+		}
+	}
+	return n
+}
+
+func fromJDN(n int64) Date {
+	// the mean year is 35975351/98496 days
+	year := int(calendrical.FloorDiv((n-epoch)*98496, 35975351))
+	for newYear(year+1) <= n {
+		year++
+	}
+	for newYear(year) > n {
+		year--
+	}
+
+	month := Tishrei
+	if n >= (Date{year, Nisan, 1}).jdn() {
+		month = Nisan
+	}
+	for n > (Date{year, month, DaysInMonth(year, month)}).jdn() {
+		month++
+		if month > lastMonth(year) {
+			month = Nisan
+		}
+	}
+	return Date{year, month, int(n-(Date{year, month, 1}).jdn()) + 1}
+}
+
+// lastMonth returns the last month of the numbering in `year`, the
+// month before Nisan.
+func lastMonth(year int) Month {
+	if IsLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
@@ -0,0 +1,218 @@
+package hebrew_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/hebrew"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFromTime(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected Date
+	}{
+		{date(1900, time.January, 1), Date{5660, Shevat, 1}},
+		{date(1950, time.June, 15), Date{5710, Sivan, 30}},
+		{date(1995, time.October, 1), Date{5756, Tishrei, 7}},
+		{date(2015, time.March, 20), Date{5775, Adar, 29}},
+		{date(2015, time.April, 4), Date{5775, Nisan, 15}},
+		{date(2015, time.September, 14), Date{5776, Tishrei, 1}},
+		{date(2015, time.December, 12), Date{5776, Kislev, 30}},
+		{date(2016, time.February, 10), Date{5776, Adar, 1}},
+		{date(2016, time.March, 10), Date{5776, Adar, 30}},
+		{date(2016, time.March, 11), Date{5776, AdarII, 1}},
+		{date(2016, time.April, 9), Date{5776, Nisan, 1}},
+		{date(2016, time.October, 2), Date{5776, Elul, 29}},
+		{date(2024, time.March, 15), Date{5784, AdarII, 5}},
+		{date(2039, time.December, 31), Date{5800, Tevet, 14}},
+		{date(2100, time.December, 31), Date{5861, Kislev, 29}},
+		{time.Date(2015, time.September, 14, 23, 59, 0, 0, time.FixedZone("IST", 3*60*60)), Date{5776, Tishrei, 1}},
+	}
+
+	for _, c := range cases {
+		got := FromTime(c.t)
+		if got != c.expected {
+			t.Errorf("FromTime(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	for d := date(1900, time.January, 1); d.Year() <= 2100; d = d.AddDate(0, 0, 1) {
+		h := FromTime(d)
+		got, err := h.Time(time.UTC)
+		if err != nil || !got.Equal(d) {
+			t.Fatalf("%v.Time(UTC) == %v, %v, want %v", h, got, err, d)
+		}
+	}
+
+	for _, d := range []Date{
+		{5775, AdarII, 1},
+		{5776, AdarII, 30},
+		{5778, Cheshvan, 30},
+		{5776, Month(14), 1},
+		{5776, Nisan, 0},
+	} {
+		if _, err := d.Time(time.UTC); err == nil {
+			t.Errorf("%v.Time(UTC) returned no error", d)
+		}
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	cases := []struct {
+		year     int
+		expected int
+	}{
+		{5775, 354},
+		{5776, 385},
+		{5777, 353},
+		{5778, 354},
+		{5779, 385},
+		{5780, 355},
+		{5781, 353},
+		{5782, 384},
+		{5783, 355},
+		{5784, 383},
+		{5785, 355},
+	}
+
+	for _, c := range cases {
+		got := DaysInYear(c.year)
+		if got != c.expected {
+			t.Errorf("DaysInYear(%v) == %v, want %v", c.year, got, c.expected)
+		}
+		if IsLeapYear(c.year) != (c.expected > 355) {
+			t.Errorf("IsLeapYear(%v) == %v, want %v", c.year, IsLeapYear(c.year), c.expected > 355)
+		}
+		sum := 0
+		for m := Nisan; m <= AdarII; m++ {
+			if m != AdarII || IsLeapYear(c.year) {
+				sum += DaysInMonth(c.year, m)
+			}
+		}
+		if sum != c.expected {
+			t.Errorf("months of %v have %v days, want %v", c.year, sum, c.expected)
+		}
+	}
+}
+
+func TestDateString(t *testing.T) {
+	cases := []struct {
+		d        Date
+		expected string
+	}{
+		{Date{5775, Nisan, 15}, "15 Nisan 5775"},
+		{Date{5775, Adar, 1}, "1 Adar 5775"},
+		{Date{5776, Adar, 1}, "1 Adar I 5776"},
+		{Date{5776, AdarII, 1}, "1 Adar II 5776"},
+	}
+
+	for _, c := range cases {
+		got := c.d.String()
+		if got != c.expected {
+			t.Errorf("%#v.String() == %v, want %v", c.d, got, c.expected)
+		}
+	}
+}
+
+func TestHolidayDates(t *testing.T) {
+	cases := []struct {
+		year                                               int
+		passover, shavuot, roshHashanah, yomKippur, sukkot time.Time
+	}{
+		{2015, date(2015, time.April, 4), date(2015, time.May, 24), date(2015, time.September, 14), date(2015, time.September, 23), date(2015, time.September, 28)},
+		{2016, date(2016, time.April, 23), date(2016, time.June, 12), date(2016, time.October, 3), date(2016, time.October, 12), date(2016, time.October, 17)},
+		{2023, date(2023, time.April, 6), date(2023, time.May, 26), date(2023, time.September, 16), date(2023, time.September, 25), date(2023, time.September, 30)},
+		{2024, date(2024, time.April, 23), date(2024, time.June, 12), date(2024, time.October, 3), date(2024, time.October, 12), date(2024, time.October, 17)},
+		{2025, date(2025, time.April, 13), date(2025, time.June, 2), date(2025, time.September, 23), date(2025, time.October, 2), date(2025, time.October, 7)},
+		{2026, date(2026, time.April, 2), date(2026, time.May, 22), date(2026, time.September, 12), date(2026, time.September, 21), date(2026, time.September, 26)},
+	}
+
+	for _, c := range cases {
+		for _, h := range []struct {
+			name     string
+			fn       func(int) time.Time
+			expected time.Time
+		}{
+			{"Passover", Passover, c.passover},
+			{"Shavuot", Shavuot, c.shavuot},
+			{"RoshHashanah", RoshHashanah, c.roshHashanah},
+			{"YomKippur", YomKippur, c.yomKippur},
+			{"Sukkot", Sukkot, c.sukkot},
+		} {
+			got := h.fn(c.year)
+			if !got.Equal(h.expected) {
+				t.Errorf("%s(%v) == %v, want %v", h.name, c.year, got, h.expected)
+			}
+		}
+	}
+}
+
+func TestRoshHashanahPostponements(t *testing.T) {
+	for year := 1900; year <= 2100; year++ {
+		switch w := RoshHashanah(year).Weekday(); w {
+		case time.Sunday, time.Wednesday, time.Friday:
+			t.Errorf("RoshHashanah(%v) is on a %v", year, w)
+		}
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	var israel, diaspora []time.Time
+	for d := date(2015, time.January, 1); d.Year() == 2015; d = d.AddDate(0, 0, 1) {
+		if Holidays(false).IsHoliday(d) {
+			israel = append(israel, d)
+		}
+		if Holidays(true).IsHoliday(d) {
+			diaspora = append(diaspora, d)
+		}
+	}
+
+	expected := []time.Time{
+		date(2015, time.April, 4), date(2015, time.April, 10), date(2015, time.May, 24),
+		date(2015, time.September, 14), date(2015, time.September, 15), date(2015, time.September, 23),
+		date(2015, time.September, 28), date(2015, time.October, 5),
+	}
+	if !equal(israel, expected) {
+		t.Errorf("Holidays(false) in 2015 == %v, want %v", israel, expected)
+	}
+
+	expected = []time.Time{
+		date(2015, time.April, 4), date(2015, time.April, 5), date(2015, time.April, 10), date(2015, time.April, 11),
+		date(2015, time.May, 24), date(2015, time.May, 25),
+		date(2015, time.September, 14), date(2015, time.September, 15), date(2015, time.September, 23),
+		date(2015, time.September, 28), date(2015, time.September, 29), date(2015, time.October, 5), date(2015, time.October, 6),
+	}
+	if !equal(diaspora, expected) {
+		t.Errorf("Holidays(true) in 2015 == %v, want %v", diaspora, expected)
+	}
+}
+
+func equal(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCalendar(t *testing.T) {
+	d := date(2016, time.March, 20)
+	year, month, day := Calendar.Date(d)
+	if y, m, dd := 5776, 7, 10; year != y || int(month) != m || day != dd {
+		t.Errorf("Calendar.Date(%v) == %v, %v, %v, want %v, %v, %v", d, year, int(month), day, y, m, dd)
+	}
+	if got := Calendar.Time(year, month, day, time.UTC); !got.Equal(d) {
+		t.Errorf("Calendar.Time(%v, %v, %v, UTC) == %v, want %v", year, int(month), day, got, d)
+	}
+}
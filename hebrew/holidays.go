@@ -0,0 +1,80 @@
+package hebrew
+
+import (
+	"time"
+
+	"github.com/justrudd/timex"
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// The holidays below take a Gregorian year and return the civil date of
+// the first day of the holiday in that year at midnight UTC. Each begins
+// at sunset on the evening before.
+
+// RoshHashanah returns the date of Rosh Hashanah, 1 Tishrei, the Jewish
+// New Year. It is in September or early October.
+func RoshHashanah(year int) time.Time {
+	return gregorian(Date{year + 3761, Tishrei, 1})
+}
+
+// YomKippur returns the date of Yom Kippur, 10 Tishrei.
+func YomKippur(year int) time.Time {
+	return gregorian(Date{year + 3761, Tishrei, 10})
+}
+
+// Sukkot returns the date of the first day of Sukkot, 15 Tishrei.
+func Sukkot(year int) time.Time {
+	return gregorian(Date{year + 3761, Tishrei, 15})
+}
+
+// Passover returns the date of the first day of Passover, 15 Nisan. It
+// is in late March or April.
+func Passover(year int) time.Time {
+	return gregorian(Date{year + 3760, Nisan, 15})
+}
+
+// Shavuot returns the date of Shavuot, 6 Sivan, seven weeks after
+// Passover.
+func Shavuot(year int) time.Time {
+	return gregorian(Date{year + 3760, Sivan, 6})
+}
+
+// Holidays returns a timex.HolidayCalendar of the festival days on which
+// work is not done: the two days of Rosh Hashanah, Yom Kippur, the first
+// day of Sukkot, Shemini Atzeret, and the first and last days of
+// Passover and Shavuot. Outside of Israel, when `diaspora` is set, the
+// second day of each festival other than Yom Kippur is kept as well,
+// including Simchat Torah.
+func Holidays(diaspora bool) timex.HolidayCalendar {
+	return timex.HolidayFunc(func(t time.Time) bool {
+		d := FromTime(t)
+		switch d.Month {
+		case Tishrei:
+			switch d.Day {
+			case 1, 2, 10, 15, 22:
+				return true
+			case 16, 23:
+				return diaspora
+			}
+		case Nisan:
+			switch d.Day {
+			case 15, 21:
+				return true
+			case 16, 22:
+				return diaspora
+			}
+		case Sivan:
+			switch d.Day {
+			case 6:
+				return true
+			case 7:
+				return diaspora
+			}
+		}
+		return false
+	})
+}
+
+func gregorian(d Date) time.Time {
+	return calendrical.FromJDN(d.jdn(), time.UTC)
+}
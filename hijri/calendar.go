@@ -0,0 +1,58 @@
+package hijri
+
+import (
+	"time"
+
+	"github.com/justrudd/timex"
+)
+
+// TabularCalendar and UmmAlQuraCalendar are the variants of the Hijri
+// calendar as a timex.Calendar, for use with its generic adjusters. As a
+// timex.Calendar cannot return an error, UmmAlQuraCalendar panics for
+// dates outside of its table.
+var (
+	TabularCalendar   timex.Calendar = calendar{Tabular}
+	UmmAlQuraCalendar timex.Calendar = calendar{UmmAlQura}
+)
+
+type calendar struct {
+	c Calendar
+}
+
+func (c calendar) Date(t time.Time) (int, time.Month, int) {
+	d, err := c.c.FromTime(t)
+	if err != nil {
+		panic(err)
+	}
+	return d.Year, time.Month(d.Month), d.Day
+}
+
+func (c calendar) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	t, err := c.c.Time(Date{year, Month(month), 1}, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t.AddDate(0, 0, day-1)
+}
+
+func (c calendar) IsLeapYear(year int) bool {
+	days, err := c.c.DaysInYear(year)
+	if err != nil {
+		panic(err)
+	}
+	return days == 355
+}
+
+func (calendar) MonthsInYear(year int) int {
+	return 12
+}
+
+func (c calendar) DaysInMonth(year int, month time.Month) int {
+	days, err := c.c.DaysInMonth(year, Month(month))
+	if err != nil {
+		panic(err)
+	}
+	return days
+}
+
+func (c calendar) String() string { return c.c.String() }
@@ -0,0 +1,206 @@
+// Package hijri converts between time.Time and dates of the Islamic
+// (Hijri) calendar and computes the dates of Ramadan and the two Eids.
+//
+// Two variants are supported. Tabular is the arithmetic calendar, which
+// needs no data and is defined for every year. UmmAlQura is the official
+// calendar of Saudi Arabia, whose months follow a published table and
+// so are only known for the years 1300 to 1600 AH (1882 to 2174);
+// conversions outside of those years return an error.
+//
+// Dates in the calendar actually observed for religious purposes depend
+// on the sighting of the new moon and may differ from both by a day. A
+// Hijri day begins at sunset. This package converts the civil date of a
+// time.Time.
+package hijri
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Month is a month of the Hijri calendar.
+type Month int
+
+// The months of the Hijri calendar.
+const (
+	Muharram Month = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlAwwal
+	JumadaAlThani
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+var monthNames = [...]string{
+	Muharram:      "Muharram",
+	Safar:         "Safar",
+	RabiAlAwwal:   "Rabi al-Awwal",
+	RabiAlThani:   "Rabi al-Thani",
+	JumadaAlAwwal: "Jumada al-Awwal",
+	JumadaAlThani: "Jumada al-Thani",
+	Rajab:         "Rajab",
+	Shaban:        "Shaban",
+	Ramadan:       "Ramadan",
+	Shawwal:       "Shawwal",
+	DhuAlQadah:    "Dhu al-Qadah",
+	DhuAlHijjah:   "Dhu al-Hijjah",
+}
+
+func (m Month) String() string {
+	if m < Muharram || m > DhuAlHijjah {
+		return "Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return monthNames[m]
+}
+
+// Date is a date in the Hijri calendar. Years are counted from the
+// Hijra, anno Hegirae; 1445 AH began in July 2023.
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// String returns the date as in "1 Ramadan 1445 AH".
+func (d Date) String() string {
+	return strconv.Itoa(d.Day) + " " + d.Month.String() + " " + strconv.Itoa(d.Year) + " AH"
+}
+
+// Calendar is a variant of the Hijri calendar.
+type Calendar int
+
+const (
+	// Tabular is the arithmetic Islamic calendar. Odd months have 30
+	// days and even months 29, except that Dhu al-Hijjah has 30 days in
+	// the 11 leap years of each 30 year cycle. Day 1 is Friday, July
+	// 16th, 622 in the Julian calendar.
+	Tabular Calendar = iota
+	// UmmAlQura is the calendar of Saudi Arabia, computed in advance
+	// from the astronomical new moon as seen from Mecca.
+	UmmAlQura
+)
+
+func (c Calendar) String() string {
+	switch c {
+	case Tabular:
+		return "Tabular"
+	case UmmAlQura:
+		return "UmmAlQura"
+	}
+	return "Calendar(" + strconv.Itoa(int(c)) + ")"
+}
+
+// FromTime returns the Hijri date of the civil date of `t`. An error is
+// returned for Umm al-Qura dates outside of its table.
+func (c Calendar) FromTime(t time.Time) (Date, error) {
+	n := calendrical.JDN(t)
+	if c == UmmAlQura {
+		return ummAlQuraFromJDN(n, t)
+	}
+	return tabularFromJDN(n), nil
+}
+
+// Time returns a new time.Time at midnight in `loc` on the civil date
+// that corresponds to the Hijri date `d`. An error is returned for
+// months and days that do not exist in the year and for Umm al-Qura
+// years outside of its table.
+func (c Calendar) Time(d Date, loc *time.Location) (time.Time, error) {
+	days, err := c.DaysInMonth(d.Year, d.Month)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d.Day < 1 || d.Day > days {
+		return time.Time{}, fmt.Errorf("hijri: invalid date %v", d)
+	}
+	if c == UmmAlQura {
+		return calendrical.FromJDN(ummAlQuraJDN(d), loc), nil
+	}
+	return calendrical.FromJDN(tabularJDN(d), loc), nil
+}
+
+// DaysInMonth returns the number of days, 29 or 30, in month `m` of
+// `year`.
+func (c Calendar) DaysInMonth(year int, m Month) (int, error) {
+	if m < Muharram || m > DhuAlHijjah {
+		return 0, fmt.Errorf("hijri: invalid month %d", int(m))
+	}
+	if c == UmmAlQura {
+		mask, err := ummAlQuraMonths(year)
+		if err != nil {
+			return 0, err
+		}
+		return 29 + int(mask>>uint(m-1)&1), nil
+	}
+	if m%2 == 1 || m == DhuAlHijjah && IsLeapYear(year) {
+		return 30, nil
+	}
+	return 29, nil
+}
+
+// DaysInYear returns the number of days, 354 or 355, in `year`.
+func (c Calendar) DaysInYear(year int) (int, error) {
+	n := 0
+	for m := Muharram; m <= DhuAlHijjah; m++ {
+		days, err := c.DaysInMonth(year, m)
+		if err != nil {
+			return 0, err
+		}
+		n += days
+	}
+	return n, nil
+}
+
+// The holidays below take a Hijri year, as a Gregorian year may hold two
+// of each, and return the civil date of the holiday at midnight UTC.
+
+// Ramadan returns the first day of Ramadan, the month of fasting, in
+// `year`.
+func (c Calendar) Ramadan(year int) (time.Time, error) {
+	return c.Time(Date{year, Ramadan, 1}, time.UTC)
+}
+
+// EidAlFitr returns the date of Eid al-Fitr, 1 Shawwal, which ends the
+// fast of Ramadan.
+func (c Calendar) EidAlFitr(year int) (time.Time, error) {
+	return c.Time(Date{year, Shawwal, 1}, time.UTC)
+}
+
+// EidAlAdha returns the date of Eid al-Adha, 10 Dhu al-Hijjah.
+func (c Calendar) EidAlAdha(year int) (time.Time, error) {
+	return c.Time(Date{year, DhuAlHijjah, 10}, time.UTC)
+}
+
+// IsLeapYear returns whether `year` of the Tabular calendar has 355
+// days. Leap years are years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29
+// of the 30 year cycle.
+func IsLeapYear(year int) bool {
+	return calendrical.Mod(14+11*year, 30) < 11
+}
+
+// tabularEpoch is the Julian Day Number of 1 Muharram 1 in the Tabular
+// calendar.
+const tabularEpoch = 1948440
+
+func tabularJDN(d Date) int64 {
+	y := int64(d.Year)
+	return tabularEpoch - 1 + (y-1)*354 + calendrical.FloorDiv(3+11*y, 30) +
+		29*int64(d.Month-1) + int64(d.Month/2) + int64(d.Day)
+}
+
+func tabularFromJDN(n int64) Date {
+	year := int(calendrical.FloorDiv(30*(n-tabularEpoch)+10646, 10631))
+	month := Muharram
+	for month < DhuAlHijjah && n >= tabularJDN(Date{year, month + 1, 1}) {
+		month++
+	}
+	return Date{year, month, int(n-tabularJDN(Date{year, month, 1})) + 1}
+}
@@ -0,0 +1,188 @@
+package hijri_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/hijri"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFromTime(t *testing.T) {
+	cases := []struct {
+		t                  time.Time
+		tabular, ummAlQura Date
+	}{
+		{date(1882, time.November, 12), Date{1300, Muharram, 1}, Date{1300, Muharram, 1}},
+		{date(2024, time.March, 11), Date{1445, Ramadan, 1}, Date{1445, Ramadan, 1}},
+		{date(2024, time.April, 10), Date{1445, Shawwal, 1}, Date{1445, Shawwal, 1}},
+		{date(2024, time.June, 16), Date{1445, DhuAlHijjah, 9}, Date{1445, DhuAlHijjah, 10}},
+		{date(2100, time.January, 1), Date{1523, Shawwal, 19}, Date{1523, Shawwal, 20}},
+		{date(2174, time.November, 25), Date{1600, DhuAlHijjah, 30}, Date{1600, DhuAlHijjah, 30}},
+		{time.Date(2024, time.March, 11, 23, 0, 0, 0, time.FixedZone("AST", 3*60*60)), Date{1445, Ramadan, 1}, Date{1445, Ramadan, 1}},
+	}
+
+	for _, c := range cases {
+		got, err := Tabular.FromTime(c.t)
+		if err != nil || got != c.tabular {
+			t.Errorf("Tabular.FromTime(%v) == %v, %v, want %v", c.t, got, err, c.tabular)
+		}
+		got, err = UmmAlQura.FromTime(c.t)
+		if err != nil || got != c.ummAlQura {
+			t.Errorf("UmmAlQura.FromTime(%v) == %v, %v, want %v", c.t, got, err, c.ummAlQura)
+		}
+	}
+
+	got, err := Tabular.FromTime(date(1600, time.January, 1))
+	if expected := (Date{1008, JumadaAlThani, 14}); err != nil || got != expected {
+		t.Errorf("Tabular.FromTime(1600-01-01) == %v, %v, want %v", got, err, expected)
+	}
+	for _, d := range []time.Time{date(1882, time.November, 11), date(2174, time.November, 26)} {
+		if got, err := UmmAlQura.FromTime(d); err == nil {
+			t.Errorf("UmmAlQura.FromTime(%v) == %v, want an error", d, got)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	for _, c := range []Calendar{Tabular, UmmAlQura} {
+		for d := date(1882, time.November, 12); d.Year() < 2175; d = d.AddDate(0, 0, 1) {
+			h, err := c.FromTime(d)
+			if err != nil {
+				break
+			}
+			got, err := c.Time(h, time.UTC)
+			if err != nil || !got.Equal(d) {
+				t.Fatalf("%v.Time(%v, UTC) == %v, %v, want %v", c, h, got, err, d)
+			}
+		}
+	}
+
+	cases := []struct {
+		c Calendar
+		d Date
+	}{
+		{Tabular, Date{1445, Shaban, 30}},
+		{Tabular, Date{1444, DhuAlHijjah, 30}},
+		{Tabular, Date{1445, Month(13), 1}},
+		{Tabular, Date{1445, Ramadan, 0}},
+		{UmmAlQura, Date{1446, Ramadan, 30}},
+		{UmmAlQura, Date{1299, DhuAlHijjah, 1}},
+		{UmmAlQura, Date{1601, Muharram, 1}},
+	}
+	for _, c := range cases {
+		if got, err := c.c.Time(c.d, time.UTC); err == nil {
+			t.Errorf("%v.Time(%v, UTC) == %v, want an error", c.c, c.d, got)
+		}
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	cases := []struct {
+		c        Calendar
+		year     int
+		expected int
+	}{
+		{Tabular, 1444, 354},
+		{Tabular, 1445, 355},
+		{UmmAlQura, 1444, 354},
+		{UmmAlQura, 1445, 354},
+		{UmmAlQura, 1446, 354},
+	}
+
+	for _, c := range cases {
+		got, err := c.c.DaysInYear(c.year)
+		if err != nil || got != c.expected {
+			t.Errorf("%v.DaysInYear(%v) == %v, %v, want %v", c.c, c.year, got, err, c.expected)
+		}
+	}
+
+	if got, err := UmmAlQura.DaysInMonth(1601, Muharram); err == nil {
+		t.Errorf("UmmAlQura.DaysInMonth(1601, Muharram) == %v, want an error", got)
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	var leap []int
+	for year := 1; year <= 30; year++ {
+		if IsLeapYear(year) {
+			leap = append(leap, year)
+		}
+	}
+	expected := []int{2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29}
+	if len(leap) != len(expected) {
+		t.Fatalf("leap years of the first cycle == %v, want %v", leap, expected)
+	}
+	for i := range leap {
+		if leap[i] != expected[i] {
+			t.Fatalf("leap years of the first cycle == %v, want %v", leap, expected)
+		}
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	cases := []struct {
+		year                          int
+		ramadan, eidAlFitr, eidAlAdha time.Time
+	}{
+		{1436, date(2015, time.June, 18), date(2015, time.July, 17), date(2015, time.September, 23)},
+		{1445, date(2024, time.March, 11), date(2024, time.April, 10), date(2024, time.June, 16)},
+		{1446, date(2025, time.March, 1), date(2025, time.March, 30), date(2025, time.June, 6)},
+	}
+
+	for _, c := range cases {
+		for _, h := range []struct {
+			name     string
+			fn       func(int) (time.Time, error)
+			expected time.Time
+		}{
+			{"Ramadan", UmmAlQura.Ramadan, c.ramadan},
+			{"EidAlFitr", UmmAlQura.EidAlFitr, c.eidAlFitr},
+			{"EidAlAdha", UmmAlQura.EidAlAdha, c.eidAlAdha},
+		} {
+			got, err := h.fn(c.year)
+			if err != nil || !got.Equal(h.expected) {
+				t.Errorf("UmmAlQura.%s(%v) == %v, %v, want %v", h.name, c.year, got, err, h.expected)
+			}
+		}
+	}
+
+	if got, err := UmmAlQura.Ramadan(1700); err == nil {
+		t.Errorf("UmmAlQura.Ramadan(1700) == %v, want an error", got)
+	}
+	if got, err := Tabular.Ramadan(1700); err != nil || got.Year() != 2271 {
+		t.Errorf("Tabular.Ramadan(1700) == %v, %v, want a date in 2271", got, err)
+	}
+}
+
+func TestString(t *testing.T) {
+	if got, expected := (Date{1445, RabiAlAwwal, 12}).String(), "12 Rabi al-Awwal 1445 AH"; got != expected {
+		t.Errorf("String() == %v, want %v", got, expected)
+	}
+	if got, expected := UmmAlQura.String(), "UmmAlQura"; got != expected {
+		t.Errorf("UmmAlQura.String() == %v, want %v", got, expected)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	d := date(2024, time.March, 20)
+	year, month, day := UmmAlQuraCalendar.Date(d)
+	if y, m, dd := 1445, 9, 10; year != y || int(month) != m || day != dd {
+		t.Errorf("UmmAlQuraCalendar.Date(%v) == %v, %v, %v, want %v, %v, %v", d, year, int(month), day, y, m, dd)
+	}
+	if got := UmmAlQuraCalendar.Time(year, month, day, time.UTC); !got.Equal(d) {
+		t.Errorf("UmmAlQuraCalendar.Time(%v, %v, %v, UTC) == %v, want %v", year, int(month), day, got, d)
+	}
+}
+
+func TestCalendarPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("UmmAlQuraCalendar.Date(1800-01-01) did not panic")
+		}
+	}()
+	UmmAlQuraCalendar.Date(date(1800, time.January, 1))
+}
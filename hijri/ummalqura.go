@@ -0,0 +1,109 @@
+package hijri
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+const (
+	// ummAlQuraFirstYear is the first year of ummAlQuraTable.
+	ummAlQuraFirstYear = 1300
+	// ummAlQuraEpoch is the Julian Day Number of 1 Muharram 1300,
+	// November 12th, 1882.
+	ummAlQuraEpoch = 2408762
+)
+
+// ummAlQuraTable holds the lengths of the months of the Umm al-Qura
+// calendar from 1300 to 1600 AH, one entry per year. Bit 0 is set when
+// Muharram has 30 days rather than 29, bit 1 for Safar, and so on.
+var ummAlQuraTable = [...]uint16{
+	0x555, 0x2ab, 0x937, 0x2b6, 0x576, 0x36c, 0xb55, 0xaaa, 0x956, 0x49e, // 1300
+	0x95d, 0x2ba, 0x5b5, 0x3aa, 0xb4b, 0xa96, 0x52e, 0x2ad, 0x56d, 0xb5a, // 1310
+	0x752, 0xf25, 0xe8a, 0xd16, 0xa56, 0xab5, 0x6b4, 0xda9, 0xb92, 0xb25, // 1320
+	0x64b, 0xa9b, 0x35a, 0x6d9, 0x5d4, 0xda5, 0xd4a, 0xa95, 0x536, 0x975, // 1330
+	0x2f4, 0x6e9, 0x6d4, 0x6a9, 0x535, 0x25d, 0x4bd, 0x9ba, 0x3b4, 0xb69, // 1340
+	0xb2a, 0xa55, 0x4ad, 0xa5d, 0x2da, 0x6d9, 0xeaa, 0xe94, 0xd2a, 0xc56, // 1350
+	0x4ae, 0xa6d, 0x56a, 0xd55, 0xd4a, 0xa93, 0x52b, 0xa5b, 0x53a, 0x6b5, // 1360
+	0xea9, 0xd52, 0xd29, 0xa55, 0x4ad, 0x56d, 0xaea, 0x6e4, 0xed1, 0xda2, // 1370
+	0xaaa, 0x95a, 0x2da, 0x5b9, 0xbb2, 0x764, 0x6c9, 0x555, 0x2ab, 0x4db, // 1380
+	0xaba, 0x5b4, 0xda9, 0xd52, 0xaa5, 0x92d, 0x26d, 0x8ed, 0x2da, 0xad5, // 1390
+	0xaa5, 0xa4b, 0x497, 0x937, 0x2b6, 0x975, 0xd69, 0xd52, 0xc95, 0x92b, // 1400
+	0x25b, 0x4db, 0x9d5, 0x5d2, 0xda5, 0xd4a, 0xa95, 0x54d, 0xaad, 0x3aa, // 1410
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92, // 1420
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d, // 1430
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4, // 1440
+	0x6a5, 0xb27, 0xa4d, 0x4ad, 0x56d, 0xb5a, 0x754, 0xf49, 0xe92, 0xd26, // 1450
+	0xa56, 0x356, 0x6b5, 0xbaa, 0xb92, 0xb25, 0x68b, 0xa9b, 0x55a, 0xada, // 1460
+	0x5b4, 0xda9, 0xb52, 0xa9a, 0x536, 0x276, 0x575, 0xaf2, 0x6d4, 0x6a9, // 1470
+	0x555, 0x2ad, 0x4bd, 0x9ba, 0x574, 0xb69, 0xb52, 0xa95, 0x52d, 0xa5d, // 1480
+	0x4da, 0xad9, 0x6b2, 0xe95, 0xe2a, 0xc96, 0x92e, 0xaad, 0x56a, 0xd65, // 1490
+	0xd4a, 0xd15, 0x62b, 0xc5b, 0x53a, 0x6b5, 0xdb2, 0xd64, 0xd29, 0xa55, // 1500
+	0x4ad, 0x96d, 0xaea, 0x6e8, 0xed1, 0xda4, 0xd4a, 0xa6a, 0x2da, 0x5b9, // 1510
+	0xb72, 0xb68, 0x6d1, 0x655, 0x4ab, 0x95b, 0x2ba, 0x5b5, 0xda9, 0xd52, // 1520
+	0xca6, 0x94e, 0x46e, 0x95d, 0x4da, 0xad5, 0xaaa, 0xa4d, 0x49b, 0x937, // 1530
+	0x4b6, 0x975, 0xd6a, 0xd52, 0xaa5, 0x94b, 0x2ab, 0x55b, 0xad9, 0x5d2, // 1540
+	0xdc5, 0xd92, 0xb25, 0x555, 0xab5, 0x5b4, 0xba9, 0x7a2, 0x745, 0x593, // 1550
+	0xaab, 0x4d6, 0x9d6, 0x5d2, 0xba5, 0xb4a, 0xa95, 0x4ad, 0x15d, 0x2dd, // 1560
+	0x9da, 0x5b4, 0x5a9, 0x52d, 0x25b, 0x8b7, 0x176, 0x56d, 0xb6a, 0xaca, // 1570
+	0xa96, 0x52b, 0x15b, 0x2bb, 0x5b6, 0xdaa, 0xb94, 0xd46, 0xa8d, 0x52d, // 1580
+	0xa9d, 0x55a, 0x755, 0x749, 0xf13, 0xe4a, 0xa96, 0x556, 0x6b5, 0xbaa, // 1590
+	0xb94, // 1600
+}
+
+// ummAlQuraStarts holds the Julian Day Number of 1 Muharram of each year
+// of ummAlQuraTable and of the year after the last.
+var ummAlQuraStarts = func() []int64 {
+	starts := make([]int64, len(ummAlQuraTable)+1)
+	starts[0] = ummAlQuraEpoch
+	for i, mask := range ummAlQuraTable {
+		days := int64(12 * 29)
+		for ; mask != 0; mask &= mask - 1 {
+			days++
+		}
+		starts[i+1] = starts[i] + days
+	}
+	return starts
+}()
+
+func ummAlQuraMonths(year int) (uint16, error) {
+	i := year - ummAlQuraFirstYear
+	if i < 0 || i >= len(ummAlQuraTable) {
+		return 0, fmt.Errorf("hijri: year %d AH is outside of the Umm al-Qura table, %d to %d AH",
+			year, ummAlQuraFirstYear, ummAlQuraFirstYear+len(ummAlQuraTable)-1)
+	}
+	return ummAlQuraTable[i], nil
+}
+
+// ummAlQuraJDN returns the Julian Day Number of `d`, which must be a
+// valid date within the table.
+func ummAlQuraJDN(d Date) int64 {
+	mask := ummAlQuraTable[d.Year-ummAlQuraFirstYear]
+	n := ummAlQuraStarts[d.Year-ummAlQuraFirstYear] + int64(d.Day) - 1
+	for m := Muharram; m < d.Month; m++ {
+		n += 29 + int64(mask>>uint(m-1)&1)
+	}
+	return n
+}
+
+func ummAlQuraFromJDN(n int64, t time.Time) (Date, error) {
+	starts := ummAlQuraStarts
+	if n < starts[0] || n >= starts[len(starts)-1] {
+		return Date{}, fmt.Errorf("hijri: %s is outside of the Umm al-Qura table, %s to %s",
+			t.Format("2006-01-02"), calendrical.FromJDN(starts[0], time.UTC).Format("2006-01-02"),
+			calendrical.FromJDN(starts[len(starts)-1]-1, time.UTC).Format("2006-01-02"))
+	}
+	i := 0
+	for n >= starts[i+1] {
+		i++
+	}
+	year, mask := ummAlQuraFirstYear+i, ummAlQuraTable[i]
+	day := int(n - starts[i])
+	month := Muharram
+	for days := 29 + int(mask&1); day >= days; days = 29 + int(mask>>uint(month-1)&1) {
+		day -= days
+		month++
+	}
+	return Date{year, month, day + 1}, nil
+}
@@ -0,0 +1,64 @@
+package timex
+
+import "time"
+
+// USFederal is the HolidayCalendar of the holidays of the US federal
+// government, on the days they are observed. See USFederalHolidays.
+var USFederal HolidayCalendar = HolidayFunc(func(t time.Time) bool {
+	return USFederalHolidays(t.Year()).IsHoliday(t)
+})
+
+// USFederalHolidays returns the days the US federal holidays are
+// observed in `year`, at midnight UTC. A holiday that falls on a
+// Saturday is observed on the Friday before and one that falls on a
+// Sunday on the Monday after, so New Year's Day of the following year
+// can be observed on December 31st.
+func USFederalHolidays(year int) Holidays {
+	var hs Holidays
+	for _, y := range []int{year, year + 1} {
+		for _, h := range usFederalHolidays(y) {
+			if h.Year() == year {
+				hs = append(hs, h)
+			}
+		}
+	}
+	return hs
+}
+
+// usFederalHolidays returns the observed days of the holidays of `year`,
+// which may include December 31st of the year before.
+func usFederalHolidays(year int) []time.Time {
+	fixed := func(m time.Month, d int) time.Time {
+		t := time.Date(year, m, d, 0, 0, 0, 0, time.UTC)
+		switch t.Weekday() {
+		case time.Saturday:
+			return t.AddDate(0, 0, -1)
+		case time.Sunday:
+			return t.AddDate(0, 0, 1)
+		}
+		return t
+	}
+	nth := func(m time.Month, w time.Weekday, n int) time.Time {
+		return NthDayOfWeek(time.Date(year, m, 1, 0, 0, 0, 0, time.UTC), w, n)
+	}
+
+	hs := []time.Time{fixed(time.January, 1)}
+	if year >= 1986 {
+		hs = append(hs, nth(time.January, time.Monday, 3)) // Martin Luther King Jr. Day
+	}
+	hs = append(hs,
+		nth(time.February, time.Monday, 3), // Washington's Birthday
+		nth(time.May, time.Monday, -1),     // Memorial Day
+	)
+	if year >= 2021 {
+		hs = append(hs, fixed(time.June, 19)) // Juneteenth
+	}
+	return append(hs,
+		fixed(time.July, 4),
+		nth(time.September, time.Monday, 1),  // Labor Day
+		nth(time.October, time.Monday, 2),    // Columbus Day
+		fixed(time.November, 11),             // Veterans Day
+		nth(time.November, time.Thursday, 4), // Thanksgiving Day
+		fixed(time.December, 25),
+	)
+}
@@ -0,0 +1,61 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestUSFederalHolidays(t *testing.T) {
+	cases := []struct {
+		year     int
+		expected []time.Time
+	}{
+		{2021, []time.Time{
+			date(2021, time.January, 1), date(2021, time.January, 18), date(2021, time.February, 15),
+			date(2021, time.May, 31), date(2021, time.June, 18), date(2021, time.July, 5),
+			date(2021, time.September, 6), date(2021, time.October, 11), date(2021, time.November, 11),
+			date(2021, time.November, 25), date(2021, time.December, 24), date(2021, time.December, 31),
+		}},
+		{2022, []time.Time{
+			date(2022, time.January, 17), date(2022, time.February, 21), date(2022, time.May, 30),
+			date(2022, time.June, 20), date(2022, time.July, 4), date(2022, time.September, 5),
+			date(2022, time.October, 10), date(2022, time.November, 11), date(2022, time.November, 24),
+			date(2022, time.December, 26),
+		}},
+		{1985, []time.Time{
+			date(1985, time.January, 1), date(1985, time.February, 18), date(1985, time.May, 27),
+			date(1985, time.July, 4), date(1985, time.September, 2), date(1985, time.October, 14),
+			date(1985, time.November, 11), date(1985, time.November, 28), date(1985, time.December, 25),
+		}},
+	}
+
+	for _, c := range cases {
+		got := USFederalHolidays(c.year)
+		if !equalTimes(got, c.expected) {
+			t.Errorf("USFederalHolidays(%d) == %v, want %v", c.year, got, c.expected)
+		}
+	}
+}
+
+func TestUSFederal(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected bool
+	}{
+		{date(2026, time.December, 25), true},
+		{time.Date(2026, time.July, 3, 15, 0, 0, 0, nyc), true},
+		{date(2026, time.July, 4), false},
+		{date(2027, time.December, 31), true},
+		{date(2028, time.January, 1), false},
+		{date(2026, time.December, 24), false},
+	}
+
+	for _, c := range cases {
+		got := USFederal.IsHoliday(c.t)
+		if got != c.expected {
+			t.Errorf("USFederal.IsHoliday(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
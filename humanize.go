@@ -0,0 +1,295 @@
+package timex
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Unit is a granularity used when describing a relative time.
+type Unit int
+
+// The units Humanize can describe a difference in, from smallest to largest.
+const (
+	UnitSecond Unit = iota
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitWeek
+	UnitMonth
+	UnitYear
+)
+
+const (
+	daysPerMonth = 365.2425 / 12
+	daysPerYear  = 365.2425
+)
+
+// Thresholds control when a Humanizer moves from one unit to the next
+// larger one. Each value is the rounded count of the unit at which the
+// next unit takes over. For example, with `Minute == 45`, 44 minutes
+// is described as "44 minutes" but 45 minutes becomes "1 hour".
+type Thresholds struct {
+	Now    time.Duration // differences smaller than this are "now"
+	Second float64
+	Minute float64
+	Hour   float64
+	Day    float64
+	Week   float64 // 0 skips weeks and goes straight from days to months
+	Month  float64
+}
+
+// Messages is the table of phrases used by a Humanizer. Replace it to
+// localize the output of Humanize. Empty phrases use those of
+// EnglishMessages.
+type Messages struct {
+	Now    string // "just now"
+	Past   string // wraps a quantity in the past, "%s ago"
+	Future string // wraps a quantity in the future, "in %s"
+
+	// Units holds the plural forms of each unit as format strings taking
+	// the count, e.g. {"%d day", "%d days"}. Plural picks the form. Units
+	// missing from the table, or without the form Plural picks, use the
+	// forms of EnglishMessages.
+	Units  map[Unit][]string
+	Plural func(n int) int // nil uses English rules
+
+	// The remaining phrases are only used for calendar-aware output.
+	Yesterday   string
+	Tomorrow    string
+	LastWeekday string // "last %s"
+	NextWeekday string // "next %s"
+	LastUnit    map[Unit]string
+	NextUnit    map[Unit]string
+	Weekdays    []string // indexed by time.Weekday; nil uses time.Weekday.String
+}
+
+// EnglishMessages is the default message table.
+var EnglishMessages = &Messages{
+	Now:    "just now",
+	Past:   "%s ago",
+	Future: "in %s",
+	Units: map[Unit][]string{
+		UnitSecond: {"%d second", "%d seconds"},
+		UnitMinute: {"%d minute", "%d minutes"},
+		UnitHour:   {"%d hour", "%d hours"},
+		UnitDay:    {"%d day", "%d days"},
+		UnitWeek:   {"%d week", "%d weeks"},
+		UnitMonth:  {"%d month", "%d months"},
+		UnitYear:   {"%d year", "%d years"},
+	},
+	Yesterday:   "yesterday",
+	Tomorrow:    "tomorrow",
+	LastWeekday: "last %s",
+	NextWeekday: "next %s",
+	LastUnit: map[Unit]string{
+		UnitWeek:  "last week",
+		UnitMonth: "last month",
+		UnitYear:  "last year",
+	},
+	NextUnit: map[Unit]string{
+		UnitWeek:  "next week",
+		UnitMonth: "next month",
+		UnitYear:  "next year",
+	},
+}
+
+// Humanizer describes times relative to a reference time. The zero value
+// is not useful; copy DefaultHumanizer and change what you need.
+type Humanizer struct {
+	Thresholds Thresholds
+
+	// Round converts fractional counts to whole ones. nil uses math.Round.
+	// math.Floor and math.Ceil are other common choices.
+	Round func(float64) float64
+
+	// Calendar enables phrases based on calendar dates rather than
+	// elapsed time: "yesterday", "last Tuesday", "next month".
+	Calendar bool
+
+	// Messages is the phrase table. nil uses EnglishMessages.
+	Messages *Messages
+
+	// WeekStart is the first day of calendar weeks, which decide "last
+	// week" and "next week". Set it from Locale.FirstDayOfWeek to follow
+	// a locale; the zero value is Sunday.
+	WeekStart time.Weekday
+}
+
+// DefaultHumanizer is the Humanizer used by Humanize.
+var DefaultHumanizer = Humanizer{
+	Thresholds: Thresholds{
+		Now:    5 * time.Second,
+		Second: 45,
+		Minute: 45,
+		Hour:   22,
+		Day:    26,
+		Month:  11,
+	},
+	Calendar: true,
+}
+
+// Humanize returns a description of `t` relative to `now` such as
+// "3 days ago", "in 2 hours", "last Tuesday" or "next month" using
+// DefaultHumanizer.
+func Humanize(t, now time.Time) string {
+	return DefaultHumanizer.Humanize(t, now)
+}
+
+// Humanize returns a description of `t` relative to `now`. Calendar
+// phrases are computed in the location of `now`.
+func (h Humanizer) Humanize(t, now time.Time) string {
+	msgs := h.Messages
+	if msgs == nil {
+		msgs = EnglishMessages
+	}
+	round := h.Round
+	if round == nil {
+		round = math.Round
+	}
+	th := h.Thresholds
+
+	d := t.Sub(now)
+	future := d > 0
+	if d < 0 {
+		d = -d
+	}
+	if d < th.Now {
+		return orEnglish(msgs.Now, EnglishMessages.Now)
+	}
+
+	secs := d.Seconds()
+	if n := round(secs); n < th.Second {
+		return h.quantity(msgs, UnitSecond, n, future)
+	}
+	mins := secs / 60
+	if n := round(mins); n < th.Minute {
+		return h.quantity(msgs, UnitMinute, n, future)
+	}
+	hours := mins / 60
+	if n := round(hours); n < th.Hour {
+		return h.quantity(msgs, UnitHour, n, future)
+	}
+
+	t = t.In(now.Location())
+	days := hours / 24
+	if h.Calendar {
+		cd := daysBetweenDates(now, t)
+		if cd == 0 {
+			return h.quantity(msgs, UnitHour, round(hours), future)
+		}
+		if cd < 0 {
+			cd = -cd
+		}
+		days = float64(cd)
+	}
+	if n := round(days); n < th.Day {
+		if h.Calendar {
+			if s := weekdayPhrase(msgs, t, now); s != "" {
+				return s
+			}
+		}
+		return h.quantity(msgs, UnitDay, n, future)
+	}
+	if th.Week > 0 {
+		if n := round(days / 7); n < th.Week {
+			return h.calendarQuantity(msgs, UnitWeek, n, future, weeksBetween(now, t, h.WeekStart))
+		}
+	}
+	if n := round(days / daysPerMonth); n < th.Month {
+		return h.calendarQuantity(msgs, UnitMonth, n, future, monthsBetween(now, t))
+	}
+	n := round(days / daysPerYear)
+	return h.calendarQuantity(msgs, UnitYear, n, future, t.Year()-now.Year())
+}
+
+// calendarQuantity uses the "last"/"next" phrase for `u` when calendar
+// phrasing is enabled and the calendar offset is exactly one unit.
+func (h Humanizer) calendarQuantity(msgs *Messages, u Unit, n float64, future bool, offset int) string {
+	if h.Calendar {
+		var s string
+		switch offset {
+		case -1:
+			s = msgs.LastUnit[u]
+		case 1:
+			s = msgs.NextUnit[u]
+		}
+		if s != "" {
+			return s
+		}
+	}
+	return h.quantity(msgs, u, n, future)
+}
+
+func (h Humanizer) quantity(msgs *Messages, u Unit, n float64, future bool) string {
+	c := int(n)
+	if c < 1 {
+		c = 1
+	}
+
+	plural := msgs.Plural
+	if plural == nil {
+		plural = englishPlural
+	}
+	forms, i := msgs.Units[u], plural(c)
+	if i < 0 || i >= len(forms) {
+		forms, i = EnglishMessages.Units[u], englishPlural(c)
+	}
+	s := fmt.Sprintf(forms[i], c)
+
+	if future {
+		return fmt.Sprintf(orEnglish(msgs.Future, EnglishMessages.Future), s)
+	}
+	return fmt.Sprintf(orEnglish(msgs.Past, EnglishMessages.Past), s)
+}
+
+// weekdayPhrase returns "yesterday", "tomorrow", "last <weekday>" or
+// "next <weekday>" when `t` falls within a week of `now`, otherwise "".
+func weekdayPhrase(msgs *Messages, t, now time.Time) string {
+	cd := daysBetweenDates(now, t)
+	switch {
+	case cd == -1:
+		return orEnglish(msgs.Yesterday, EnglishMessages.Yesterday)
+	case cd == 1:
+		return orEnglish(msgs.Tomorrow, EnglishMessages.Tomorrow)
+	case cd < 0 && DaysBetweenWeekdays(t.Weekday(), now.Weekday()) == -cd:
+		return fmt.Sprintf(orEnglish(msgs.LastWeekday, EnglishMessages.LastWeekday), weekdayName(msgs, t.Weekday()))
+	case cd > 0 && DaysBetweenWeekdays(now.Weekday(), t.Weekday()) == cd:
+		return fmt.Sprintf(orEnglish(msgs.NextWeekday, EnglishMessages.NextWeekday), weekdayName(msgs, t.Weekday()))
+	}
+	return ""
+}
+
+// orEnglish returns `s`, or the English phrase `english` when `s` is
+// empty.
+func orEnglish(s, english string) string {
+	if s == "" {
+		return english
+	}
+	return s
+}
+
+func weekdayName(msgs *Messages, w time.Weekday) string {
+	if len(msgs.Weekdays) == 7 {
+		return msgs.Weekdays[w]
+	}
+	return w.String()
+}
+
+func englishPlural(n int) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// weeksBetween returns the number of calendar weeks beginning on
+// `weekStart` from `a` to `b`.
+func weeksBetween(a, b time.Time, weekStart time.Weekday) int {
+	return daysBetweenDates(FirstDayOfWeek(a, weekStart), FirstDayOfWeek(b, weekStart)) / 7
+}
+
+// monthsBetween returns the number of calendar months from `a` to `b`.
+func monthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+}
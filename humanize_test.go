@@ -0,0 +1,136 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestHumanize(t *testing.T) {
+	// a Wednesday afternoon
+	now := time.Date(2015, time.July, 15, 14, 0, 0, 0, utc)
+
+	cases := []struct {
+		t        time.Time
+		expected string
+	}{
+		{now, "just now"},
+		{now.Add(-3 * time.Second), "just now"},
+		{now.Add(-30 * time.Second), "30 seconds ago"},
+		{now.Add(90 * time.Second), "in 2 minutes"},
+		{now.Add(-1 * time.Minute), "1 minute ago"},
+		{now.Add(-44 * time.Minute), "44 minutes ago"},
+		{now.Add(-50 * time.Minute), "1 hour ago"},
+		{now.Add(3 * time.Hour), "in 3 hours"},
+
+		// calendar phrasing
+		{time.Date(2015, time.July, 14, 9, 0, 0, 0, utc), "yesterday"},
+		{time.Date(2015, time.July, 16, 23, 0, 0, 0, utc), "tomorrow"},
+		{time.Date(2015, time.July, 12, 9, 0, 0, 0, utc), "last Sunday"},
+		{time.Date(2015, time.July, 9, 9, 0, 0, 0, utc), "last Thursday"},
+		{time.Date(2015, time.July, 20, 9, 0, 0, 0, utc), "next Monday"},
+		{time.Date(2015, time.July, 8, 9, 0, 0, 0, utc), "7 days ago"},
+		{time.Date(2015, time.July, 1, 9, 0, 0, 0, utc), "14 days ago"},
+		{time.Date(2015, time.June, 10, 9, 0, 0, 0, utc), "last month"},
+		{time.Date(2015, time.August, 20, 9, 0, 0, 0, utc), "next month"},
+		{time.Date(2015, time.October, 20, 9, 0, 0, 0, utc), "in 3 months"},
+		{time.Date(2014, time.July, 1, 9, 0, 0, 0, utc), "last year"},
+		{time.Date(2012, time.July, 1, 9, 0, 0, 0, utc), "3 years ago"},
+	}
+
+	for _, c := range cases {
+		got := Humanize(c.t, now)
+		if got != c.expected {
+			t.Errorf("Humanize(%v, %v) == %q, want %q", c.t, now, got, c.expected)
+		}
+	}
+}
+
+func TestHumanizerOptions(t *testing.T) {
+	now := time.Date(2015, time.July, 15, 14, 0, 0, 0, utc)
+
+	elapsed := DefaultHumanizer
+	elapsed.Calendar = false
+
+	floor := DefaultHumanizer
+	floor.Round = math.Floor
+
+	weeks := DefaultHumanizer
+	weeks.Thresholds.Day = 7
+	weeks.Thresholds.Week = 4
+	mondays := weeks
+	mondays.WeekStart = EnglishGB.FirstDayOfWeek
+
+	spanish := DefaultHumanizer
+	spanish.Messages = &Messages{
+		Now:    "ahora",
+		Past:   "hace %s",
+		Future: "dentro de %s",
+		Units: map[Unit][]string{
+			UnitDay:   {"%d día", "%d días"},
+			UnitMonth: {"%d mes", "%d meses"},
+		},
+		Yesterday:   "ayer",
+		Tomorrow:    "mañana",
+		LastWeekday: "el %s pasado",
+		NextWeekday: "el próximo %s",
+		Weekdays:    []string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	}
+
+	// units and forms missing from the table fall back to English
+	incomplete := weeks
+	incomplete.Messages = spanish.Messages
+	few := elapsed
+	few.Messages = &Messages{
+		Past:   "%s ago",
+		Future: "in %s",
+		Units:  map[Unit][]string{UnitDay: {"%d day", "%d days"}},
+		Plural: func(n int) int { return n % 3 },
+	}
+
+	// empty phrases fall back to English
+	empty := weeks
+	empty.Messages = &Messages{Units: spanish.Messages.Units}
+
+	cases := []struct {
+		h        Humanizer
+		t        time.Time
+		expected string
+	}{
+		{elapsed, time.Date(2015, time.July, 14, 9, 0, 0, 0, utc), "1 day ago"},
+		{elapsed, time.Date(2015, time.July, 12, 9, 0, 0, 0, utc), "3 days ago"},
+		{elapsed, time.Date(2015, time.June, 10, 9, 0, 0, 0, utc), "1 month ago"},
+		{floor, now.Add(-90 * time.Second), "1 minute ago"},
+		{weeks, time.Date(2015, time.July, 8, 9, 0, 0, 0, utc), "last week"},
+		{weeks, time.Date(2015, time.July, 1, 9, 0, 0, 0, utc), "2 weeks ago"},
+		{weeks, time.Date(2015, time.July, 5, 9, 0, 0, 0, utc), "last week"},
+		{mondays, time.Date(2015, time.July, 5, 9, 0, 0, 0, utc), "1 week ago"},
+		{mondays, time.Date(2015, time.July, 6, 9, 0, 0, 0, utc), "last week"},
+		{mondays, time.Date(2015, time.July, 27, 9, 0, 0, 0, utc), "in 2 weeks"},
+		{spanish, now, "ahora"},
+		{spanish, time.Date(2015, time.July, 14, 9, 0, 0, 0, utc), "ayer"},
+		{spanish, time.Date(2015, time.July, 12, 9, 0, 0, 0, utc), "el domingo pasado"},
+		{spanish, time.Date(2015, time.July, 17, 9, 0, 0, 0, utc), "el próximo viernes"},
+		{spanish, time.Date(2015, time.July, 1, 9, 0, 0, 0, utc), "hace 14 días"},
+		{spanish, time.Date(2015, time.October, 20, 9, 0, 0, 0, utc), "dentro de 3 meses"},
+		{spanish, now.Add(-3 * time.Hour), "hace 3 hours"},
+		{incomplete, time.Date(2015, time.July, 1, 9, 0, 0, 0, utc), "hace 2 weeks"},
+		{few, time.Date(2015, time.July, 10, 9, 0, 0, 0, utc), "5 days ago"},
+		{empty, now, "just now"},
+		{empty, time.Date(2015, time.July, 14, 9, 0, 0, 0, utc), "yesterday"},
+		{empty, time.Date(2015, time.July, 16, 20, 0, 0, 0, utc), "tomorrow"},
+		{empty, time.Date(2015, time.July, 12, 9, 0, 0, 0, utc), "last Sunday"},
+		{empty, time.Date(2015, time.July, 17, 9, 0, 0, 0, utc), "next Friday"},
+		{empty, time.Date(2015, time.July, 1, 9, 0, 0, 0, utc), "2 weeks ago"},
+		{empty, time.Date(2015, time.October, 20, 9, 0, 0, 0, utc), "in 3 meses"},
+	}
+
+	for _, c := range cases {
+		got := c.h.Humanize(c.t, now)
+		if got != c.expected {
+			t.Errorf("Humanize(%v, %v) == %q, want %q", c.t, now, got, c.expected)
+		}
+	}
+}
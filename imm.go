@@ -0,0 +1,126 @@
+package timex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Common expiry rules for futures and options.
+var (
+	// ThirdWednesday moves to the third Wednesday of the month, the IMM
+	// date.
+	ThirdWednesday = NthWeekday(time.Wednesday, 3)
+	// ThirdFriday moves to the third Friday of the month, the usual
+	// expiry of equity index futures and options.
+	ThirdFriday = NthWeekday(time.Friday, 3)
+)
+
+// immMonthCodes are the futures month codes indexed by time.Month.
+const immMonthCodes = " FGHJKMNQUVXZ"
+
+// IMMDate returns the IMM date of `month` in `year`, the third
+// Wednesday of the month, at midnight UTC.
+func IMMDate(year int, month time.Month) time.Time {
+	return ThirdWednesday(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// IsIMMDate returns whether `t` falls on the IMM date of March, June,
+// September or December.
+func IsIMMDate(t time.Time) bool {
+	return t.Month()%3 == 0 && ThirdWednesday(t).Day() == t.Day()
+}
+
+// NextIMMDate returns a new time.Time for the first IMM date of March,
+// June, September or December after `t`. The clock of the time is not
+// adjusted.
+func NextIMMDate(t time.Time) time.Time {
+	for nt := FirstDayOfMonth(t); ; nt = FirstDayOfNextMonth(nt) {
+		if nt.Month()%3 != 0 {
+			continue
+		}
+		if imm := ThirdWednesday(nt); daysBetweenDates(t, imm) > 0 {
+			return imm
+		}
+	}
+}
+
+// IMMCode returns the futures code for the month of `t`, a month letter
+// followed by the last two digits of the year. March 2027 is "H27".
+func IMMCode(t time.Time) string {
+	return fmt.Sprintf("%c%02d", immMonthCodes[t.Month()], t.Year()%100)
+}
+
+// ParseIMMCode returns the IMM date for a futures code such as "H27" or
+// "Z5". The code names a month and the last one or two digits of a
+// year; the year chosen is the first whose IMM date is on or after
+// `ref`. The result is at midnight in the location of `ref`.
+func ParseIMMCode(code string, ref time.Time) (time.Time, error) {
+	if len(code) < 2 || len(code) > 3 {
+		return time.Time{}, fmt.Errorf("timex: invalid IMM code %q", code)
+	}
+	m := strings.Index(immMonthCodes, strings.ToUpper(code[:1]))
+	if m < 1 {
+		return time.Time{}, fmt.Errorf("timex: invalid IMM month code in %q", code)
+	}
+	digits, mod := 0, 1
+	for _, c := range code[1:] {
+		if c < '0' || c > '9' {
+			return time.Time{}, fmt.Errorf("timex: invalid IMM year in %q", code)
+		}
+		digits = digits*10 + int(c-'0')
+		mod *= 10
+	}
+
+	ref = BeginningOfDay(ref)
+	year := ref.Year() - ref.Year()%mod + digits
+	if year < ref.Year() {
+		year += mod
+	}
+	for {
+		imm := ThirdWednesday(time.Date(year, time.Month(m), 1, 0, 0, 0, 0, ref.Location()))
+		if !imm.Before(ref) {
+			return imm, nil
+		}
+		year += mod
+	}
+}
+
+// NthWeekday returns an Adjuster that moves to the `n`th `w` of the
+// month. See NthDayOfWeek.
+func NthWeekday(w time.Weekday, n int) Adjuster {
+	return func(t time.Time) time.Time {
+		return NthDayOfWeek(t, w, n)
+	}
+}
+
+// LastBusinessDay returns an Adjuster that moves to the last business
+// day of the month.
+func LastBusinessDay(cal HolidayCalendar) Adjuster {
+	return func(t time.Time) time.Time {
+		return Adjust(t, MonthEnd, cal)
+	}
+}
+
+// BusinessDaysBefore returns an Adjuster that moves back `n` business
+// days. Chained after another Adjuster it expresses rules such as "two
+// business days before the third Wednesday".
+func BusinessDaysBefore(n int, cal HolidayCalendar) Adjuster {
+	return func(t time.Time) time.Time {
+		for i := 0; i < n; i++ {
+			t = PrevBusinessDay(t, cal)
+		}
+		return t
+	}
+}
+
+// BusinessDaysAfter returns an Adjuster that moves forward `n` business
+// days.
+func BusinessDaysAfter(n int, cal HolidayCalendar) Adjuster {
+	return func(t time.Time) time.Time {
+		for i := 0; i < n; i++ {
+			t = NextBusinessDay(t, cal)
+		}
+		return t
+	}
+}
@@ -0,0 +1,158 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestIMMDate(t *testing.T) {
+	cases := []struct {
+		year     int
+		month    time.Month
+		expected time.Time
+	}{
+		{2015, time.March, date(2015, time.March, 18)},
+		{2015, time.June, date(2015, time.June, 17)},
+		{2026, time.April, date(2026, time.April, 15)},
+		// the month starts on a Wednesday
+		{2015, time.July, date(2015, time.July, 15)},
+	}
+
+	for _, c := range cases {
+		got := IMMDate(c.year, c.month)
+		if got != c.expected {
+			t.Errorf("IMMDate(%d, %s) == %v, want %v", c.year, c.month, got, c.expected)
+		}
+	}
+}
+
+func TestIsIMMDate(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected bool
+	}{
+		{time.Date(2015, time.March, 18, 9, 30, 0, 0, nyc), true},
+		{date(2015, time.March, 11), false},
+		{date(2015, time.March, 25), false},
+		// third Wednesday, but not a quarterly month
+		{date(2015, time.July, 15), false},
+	}
+
+	for _, c := range cases {
+		got := IsIMMDate(c.t)
+		if got != c.expected {
+			t.Errorf("IsIMMDate(%v) == %t, want %t", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestNextIMMDate(t *testing.T) {
+	cases := []struct {
+		t, expected time.Time
+	}{
+		{date(2015, time.January, 5), date(2015, time.March, 18)},
+		{date(2015, time.March, 17), date(2015, time.March, 18)},
+		{date(2015, time.March, 18), date(2015, time.June, 17)},
+		{
+			time.Date(2015, time.December, 20, 9, 30, 0, 0, nyc),
+			time.Date(2016, time.March, 16, 9, 30, 0, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := NextIMMDate(c.t)
+		if got != c.expected {
+			t.Errorf("NextIMMDate(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestIMMCode(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected string
+	}{
+		{date(2027, time.March, 17), "H27"},
+		{date(2015, time.December, 16), "Z15"},
+		{date(2009, time.January, 21), "F09"},
+	}
+
+	for _, c := range cases {
+		got := IMMCode(c.t)
+		if got != c.expected {
+			t.Errorf("IMMCode(%v) == %q, want %q", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestParseIMMCode(t *testing.T) {
+	ref := date(2026, time.October, 19)
+
+	cases := []struct {
+		code     string
+		expected time.Time
+	}{
+		{"H27", date(2027, time.March, 17)},
+		{"Z26", date(2026, time.December, 16)},
+		{"v26", date(2026, time.October, 21)},
+		// already expired this century
+		{"H26", date(2126, time.March, 20)},
+		{"U7", date(2027, time.September, 15)},
+		{"Z6", date(2026, time.December, 16)},
+		{"H6", date(2036, time.March, 19)},
+	}
+
+	for _, c := range cases {
+		got, err := ParseIMMCode(c.code, ref)
+		if err != nil || got != c.expected {
+			t.Errorf("ParseIMMCode(%q, %v) == %v, %v, want %v", c.code, ref, got, err, c.expected)
+		}
+	}
+
+	for _, code := range []string{"", "H", "A27", "H2027", "Hx7"} {
+		if _, err := ParseIMMCode(code, ref); err == nil {
+			t.Errorf("ParseIMMCode(%q, %v) returned no error", code, ref)
+		}
+	}
+}
+
+func TestExpiryAdjusters(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        Adjuster
+		t        time.Time
+		expected time.Time
+	}{
+		{"ThirdFriday", ThirdFriday, date(2015, time.June, 1), date(2015, time.June, 19)},
+		{"ThirdWednesday", ThirdWednesday, date(2015, time.June, 30), date(2015, time.June, 17)},
+		{"LastBusinessDay", LastBusinessDay(holidays2015), date(2015, time.July, 1), date(2015, time.July, 31)},
+		{"LastBusinessDay", LastBusinessDay(holidays2015), date(2015, time.October, 1), date(2015, time.October, 30)},
+		{
+			"two business days before the IMM date",
+			Chain(ThirdWednesday, BusinessDaysBefore(2, holidays2015)),
+			date(2015, time.June, 1),
+			date(2015, time.June, 15),
+		},
+		{
+			"three business days after the third Friday",
+			Chain(ThirdFriday, BusinessDaysAfter(3, holidays2015)),
+			date(2015, time.May, 1),
+			date(2015, time.May, 20),
+		},
+		{
+			"business days skip holidays",
+			Chain(NthWeekday(time.Monday, -1), BusinessDaysAfter(1, holidays2015), BusinessDaysBefore(1, holidays2015)),
+			date(2015, time.May, 1),
+			date(2015, time.May, 22),
+		},
+	}
+
+	for _, c := range cases {
+		got := c.a(c.t)
+		if got != c.expected {
+			t.Errorf("%s(%v) == %v, want %v", c.name, c.t, got, c.expected)
+		}
+	}
+}
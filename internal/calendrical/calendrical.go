@@ -0,0 +1,56 @@
+// Package calendrical holds the arithmetic shared by the calendar
+// packages of timex: Julian Day Numbers and division that rounds
+// towards negative infinity.
+package calendrical
+
+import "time"
+
+// UnixEpochJDN is the Julian Day Number of January 1st, 1970, a day
+// that starts at 12:00 UTC.
+const UnixEpochJDN = 2440588
+
+const secondsPerDay = 24 * 60 * 60
+
+// JDN returns the Julian Day Number of the civil date of `t`.
+func JDN(t time.Time) int64 {
+	return DateToJDN(t.Date())
+}
+
+// FromJDN returns a new time.Time at midnight in `loc` on the civil
+// date of the Julian Day Number `n`.
+func FromJDN(n int64, loc *time.Location) time.Time {
+	y, m, d := DateFromJDN(n)
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// DateToJDN returns the Julian Day Number of a date in the proleptic
+// Gregorian calendar. Like time.Date, out of range months and days are
+// normalized.
+func DateToJDN(year int, month time.Month, day int) int64 {
+	return FloorDiv(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix(), secondsPerDay) + UnixEpochJDN
+}
+
+// DateFromJDN returns the proleptic Gregorian date of the Julian Day
+// Number `n`.
+func DateFromJDN(n int64) (year int, month time.Month, day int) {
+	return time.Unix((n-UnixEpochJDN)*secondsPerDay, 0).UTC().Date()
+}
+
+// FloorDiv returns a / b rounded towards negative infinity.
+func FloorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// Mod64 returns a modulo b with the sign of b.
+func Mod64(a, b int64) int64 {
+	return a - b*FloorDiv(a, b)
+}
+
+// Mod returns a modulo b with the sign of b.
+func Mod(a, b int) int {
+	return int(Mod64(int64(a), int64(b)))
+}
@@ -0,0 +1,84 @@
+package calendrical_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/internal/calendrical"
+)
+
+func TestJDN(t *testing.T) {
+	nyc, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		t   time.Time
+		jdn int64
+	}{
+		{time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2451545},
+		{time.Date(2000, time.January, 1, 23, 59, 0, 0, nyc), 2451545},
+		{time.Date(1970, time.January, 1, 12, 0, 0, 0, time.UTC), 2440588},
+		{time.Date(-4713, time.November, 24, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(-4713, time.November, 23, 0, 0, 0, 0, time.UTC), -1},
+	}
+
+	for _, c := range cases {
+		if got := JDN(c.t); got != c.jdn {
+			t.Errorf("JDN(%v) == %v, want %v", c.t, got, c.jdn)
+		}
+		y, m, d := c.t.Date()
+		expected := time.Date(y, m, d, 0, 0, 0, 0, nyc)
+		if got := FromJDN(c.jdn, nyc); !got.Equal(expected) {
+			t.Errorf("FromJDN(%v, nyc) == %v, want %v", c.jdn, got, expected)
+		}
+	}
+}
+
+func TestDateToJDN(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+		jdn   int64
+	}{
+		{2000, time.January, 1, 2451545},
+		{1582, time.October, 15, 2299161},
+		{-4713, time.November, 24, 0},
+	}
+
+	for _, c := range cases {
+		if got := DateToJDN(c.year, c.month, c.day); got != c.jdn {
+			t.Errorf("DateToJDN(%v, %v, %v) == %v, want %v", c.year, c.month, c.day, got, c.jdn)
+		}
+		if y, m, d := DateFromJDN(c.jdn); y != c.year || m != c.month || d != c.day {
+			t.Errorf("DateFromJDN(%v) == %v, %v, %v, want %v, %v, %v", c.jdn, y, m, d, c.year, c.month, c.day)
+		}
+	}
+
+	// months and days are normalized like time.Date
+	if got := DateToJDN(1999, time.December+1, 1); got != 2451545 {
+		t.Errorf("DateToJDN(1999, 13, 1) == %v, want 2451545", got)
+	}
+}
+
+func TestFloorDivMod(t *testing.T) {
+	cases := []struct {
+		a, b, q, r int64
+	}{
+		{7, 2, 3, 1},
+		{-7, 2, -4, 1},
+		{7, -2, -4, -1},
+		{-7, -2, 3, -1},
+		{-6, 3, -2, 0},
+	}
+
+	for _, c := range cases {
+		if got := FloorDiv(c.a, c.b); got != c.q {
+			t.Errorf("FloorDiv(%v, %v) == %v, want %v", c.a, c.b, got, c.q)
+		}
+		if got := Mod64(c.a, c.b); got != c.r {
+			t.Errorf("Mod64(%v, %v) == %v, want %v", c.a, c.b, got, c.r)
+		}
+		if got := Mod(int(c.a), int(c.b)); got != int(c.r) {
+			t.Errorf("Mod(%v, %v) == %v, want %v", c.a, c.b, got, c.r)
+		}
+	}
+}
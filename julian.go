@@ -0,0 +1,130 @@
+package timex
+
+import (
+	"math"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+const (
+	secondsPerDay = 86400
+	nanosPerDay   = secondsPerDay * int64(time.Second)
+
+	// unixEpochMJD is the Modified Julian Date of 1970-01-01 00:00 UTC.
+	unixEpochMJD = 40587
+)
+
+// ToJulianDay returns the Julian Date of `t`, the number of days since
+// noon UTC on January 1st, 4713 BC in the proleptic Julian calendar.
+// The result is split into the whole day and the fraction of the day
+// since noon, because a single float64 cannot hold a current Julian
+// Date to better than tens of microseconds while the fraction alone is
+// accurate to the nanosecond. Add them for a single value.
+//
+// Like time.Time, every day is 86400 seconds long; leap seconds are not
+// counted.
+func ToJulianDay(t time.Time) (day int64, frac float64) {
+	return splitDays(t.Unix()-secondsPerDay/2, t.Nanosecond(), calendrical.UnixEpochJDN)
+}
+
+// FromJulianDay returns the time in UTC for a Julian Date given as a
+// whole day and a fraction of a day. `frac` may be outside of [0, 1), so
+// `FromJulianDay(0, jd)` converts a single float64 at its precision.
+func FromJulianDay(day int64, frac float64) time.Time {
+	return joinDays(day-calendrical.UnixEpochJDN, frac, secondsPerDay/2)
+}
+
+// ToMJD returns the Modified Julian Date of `t`, the number of days since
+// midnight UTC on November 17th, 1858. It is the Julian Date minus
+// 2400000.5. Like ToJulianDay, the result is split into the whole day
+// and the fraction of the day since midnight.
+func ToMJD(t time.Time) (day int64, frac float64) {
+	return splitDays(t.Unix(), t.Nanosecond(), unixEpochMJD)
+}
+
+// FromMJD returns the time in UTC for a Modified Julian Date given as a
+// whole day and a fraction of a day.
+func FromMJD(day int64, frac float64) time.Time {
+	return joinDays(day-unixEpochMJD, frac, 0)
+}
+
+// ToJulianCalendar returns the date of `t` in the proleptic Julian
+// calendar. Years are astronomical, so 1 BC is year 0.
+func ToJulianCalendar(t time.Time) (year int, month time.Month, day int) {
+	return julianFromJDN(calendrical.JDN(t))
+}
+
+// FromJulianCalendar returns a new time.Time at midnight in `loc` for a
+// date in the proleptic Julian calendar. Years are astronomical, so 1 BC
+// is year 0. Like time.Date, out of range months and days are
+// normalized.
+func FromJulianCalendar(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return calendrical.FromJDN(normalizedJulianToJDN(year, month, day), loc)
+}
+
+// IsJulianLeapYear returns whether the year is a leap year in the Julian
+// calendar, which has one every fourth year.
+func IsJulianLeapYear(year int) bool {
+	return year%4 == 0
+}
+
+// DaysInJulianMonth returns the number of days in the month for the year
+// in the Julian calendar.
+func DaysInJulianMonth(y int, m time.Month) int {
+	if m == time.February && IsJulianLeapYear(y) {
+		return 29
+	}
+	return daysInMonth[m]
+}
+
+// splitDays converts seconds and nanoseconds since an epoch to a day
+// number and fraction, where `epochDay` is the day number of the epoch.
+func splitDays(sec int64, nsec int, epochDay int64) (int64, float64) {
+	day := calendrical.FloorDiv(sec, secondsPerDay)
+	rem := sec - day*secondsPerDay
+	nanos := rem*int64(time.Second) + int64(nsec)
+	return day + epochDay, float64(nanos) / float64(nanosPerDay)
+}
+
+// joinDays converts days since the Unix epoch day and a fraction of a
+// day to a time. `offset` is the number of seconds after midnight the
+// days start at.
+func joinDays(day int64, frac float64, offset int64) time.Time {
+	whole := math.Floor(frac)
+	day += int64(whole)
+	nanos := int64(math.Round((frac - whole) * float64(nanosPerDay)))
+	return time.Unix(day*secondsPerDay+offset, nanos).UTC()
+}
+
+// julianToJDN returns the Julian Day Number of a date in the proleptic
+// Julian calendar.
+func julianToJDN(year int, month time.Month, day int) int64 {
+	y, m := int64(year), int64(month)
+	a := (14 - m) / 12
+	y = y + 4800 - a
+	m = m + 12*a - 3
+	return int64(day) + (153*m+2)/5 + 365*y + calendrical.FloorDiv(y, 4) - 32083
+}
+
+// normalizedJulianToJDN is julianToJDN for months and days that may be
+// out of range, normalized like time.Date.
+func normalizedJulianToJDN(year int, month time.Month, day int) int64 {
+	mm := int64(month) - 1
+	year += int(calendrical.FloorDiv(mm, 12))
+	month = time.Month(mm-calendrical.FloorDiv(mm, 12)*12) + 1
+	return julianToJDN(year, month, 1) + int64(day-1)
+}
+
+// julianFromJDN returns the proleptic Julian calendar date of a Julian
+// Day Number.
+func julianFromJDN(jdn int64) (year int, month time.Month, day int) {
+	c := jdn + 32082
+	d := calendrical.FloorDiv(4*c+3, 1461)
+	e := c - calendrical.FloorDiv(1461*d, 4)
+	m := (5*e + 2) / 153
+	day = int(e - (153*m+2)/5 + 1)
+	month = time.Month(m + 3 - 12*(m/10))
+	year = int(d - 4800 + m/10)
+	return year, month, day
+}
@@ -0,0 +1,186 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestToJulianDay(t *testing.T) {
+	cases := []struct {
+		t    time.Time
+		day  int64
+		frac float64
+	}{
+		// J2000.0
+		{time.Date(2000, time.January, 1, 12, 0, 0, 0, utc), 2451545, 0},
+		{time.Date(1970, time.January, 1, 0, 0, 0, 0, utc), 2440587, 0.5},
+		{time.Date(1858, time.November, 17, 0, 0, 0, 0, utc), 2400000, 0.5},
+		// the start of the Julian Period, 4713 BC in the Julian calendar
+		{time.Date(-4713, time.November, 24, 12, 0, 0, 0, utc), 0, 0},
+		{time.Date(2015, time.July, 1, 18, 0, 0, 0, time.FixedZone("", 6*60*60)), 2457205, 0},
+		// one nanosecond is still visible in the fraction
+		{time.Date(2000, time.January, 1, 12, 0, 0, 1, utc), 2451545, 1.0 / 86400e9},
+	}
+
+	for _, c := range cases {
+		day, frac := ToJulianDay(c.t)
+		if day != c.day || frac != c.frac {
+			t.Errorf("ToJulianDay(%v) == %d, %g, want %d, %g", c.t, day, frac, c.day, c.frac)
+		}
+	}
+}
+
+func TestToMJD(t *testing.T) {
+	cases := []struct {
+		t    time.Time
+		day  int64
+		frac float64
+	}{
+		{time.Date(1858, time.November, 17, 0, 0, 0, 0, utc), 0, 0},
+		{time.Date(1858, time.November, 16, 18, 0, 0, 0, utc), -1, 0.75},
+		{time.Date(2000, time.January, 1, 12, 0, 0, 0, utc), 51544, 0.5},
+		{time.Date(1970, time.January, 1, 0, 0, 0, 0, utc), 40587, 0},
+	}
+
+	for _, c := range cases {
+		day, frac := ToMJD(c.t)
+		if day != c.day || frac != c.frac {
+			t.Errorf("ToMJD(%v) == %d, %g, want %d, %g", c.t, day, frac, c.day, c.frac)
+		}
+	}
+}
+
+func TestJulianDayRoundTrip(t *testing.T) {
+	times := []time.Time{
+		time.Date(2000, time.January, 1, 12, 0, 0, 0, utc),
+		time.Date(2015, time.July, 1, 23, 59, 59, 999999999, utc),
+		time.Date(1600, time.February, 29, 3, 4, 5, 123456789, utc),
+		time.Date(-1000, time.March, 1, 0, 0, 0, 1, utc),
+		time.Date(2262, time.April, 11, 23, 47, 16, 854775807, utc),
+	}
+
+	for _, tm := range times {
+		if got := FromJulianDay(ToJulianDay(tm)); !got.Equal(tm) {
+			t.Errorf("FromJulianDay(ToJulianDay(%v)) == %v", tm, got)
+		}
+		if got := FromMJD(ToMJD(tm)); !got.Equal(tm) {
+			t.Errorf("FromMJD(ToMJD(%v)) == %v", tm, got)
+		}
+	}
+}
+
+func TestFromJulianDayFloat(t *testing.T) {
+	cases := []struct {
+		jd       float64
+		expected time.Time
+	}{
+		{2451545.0, time.Date(2000, time.January, 1, 12, 0, 0, 0, utc)},
+		{2451544.5, time.Date(2000, time.January, 1, 0, 0, 0, 0, utc)},
+		{2457205.25, time.Date(2015, time.July, 1, 18, 0, 0, 0, utc)},
+	}
+
+	for _, c := range cases {
+		got := FromJulianDay(0, c.jd)
+		if d := got.Sub(c.expected); math.Abs(float64(d)) > float64(50*time.Microsecond) {
+			t.Errorf("FromJulianDay(0, %f) == %v, want %v", c.jd, got, c.expected)
+		}
+		day, frac := ToJulianDay(c.expected)
+		if got := float64(day) + frac; got != c.jd {
+			t.Errorf("ToJulianDay(%v) sums to %f, want %f", c.expected, got, c.jd)
+		}
+	}
+}
+
+func TestJulianCalendar(t *testing.T) {
+	cases := []struct {
+		gregorian time.Time
+		year      int
+		month     time.Month
+		day       int
+	}{
+		// the Gregorian reform
+		{date(1582, time.October, 15), 1582, time.October, 5},
+		{date(1582, time.October, 14), 1582, time.October, 4},
+		// the British reform
+		{date(1752, time.September, 14), 1752, time.September, 3},
+		{date(2000, time.January, 1), 1999, time.December, 19},
+		// February 29th in a Julian leap year that is not a Gregorian one
+		{date(1900, time.March, 13), 1900, time.February, 29},
+		{date(-4713, time.November, 24), -4712, time.January, 1},
+		{date(1, time.January, 1), 1, time.January, 3},
+	}
+
+	for _, c := range cases {
+		y, m, d := ToJulianCalendar(c.gregorian)
+		if y != c.year || m != c.month || d != c.day {
+			t.Errorf("ToJulianCalendar(%v) == %d, %s, %d, want %d, %s, %d", c.gregorian, y, m, d, c.year, c.month, c.day)
+		}
+		got := FromJulianCalendar(c.year, c.month, c.day, utc)
+		if got != c.gregorian {
+			t.Errorf("FromJulianCalendar(%d, %s, %d) == %v, want %v", c.year, c.month, c.day, got, c.gregorian)
+		}
+	}
+
+	// normalization works like time.Date
+	got := FromJulianCalendar(1999, time.December, 32, utc)
+	if want := date(2000, time.January, 14); got != want {
+		t.Errorf("FromJulianCalendar(1999, December, 32) == %v, want %v", got, want)
+	}
+	got = FromJulianCalendar(1999, 13, 1, utc)
+	if want := date(2000, time.January, 14); got != want {
+		t.Errorf("FromJulianCalendar(1999, 13, 1) == %v, want %v", got, want)
+	}
+}
+
+// The Julian Day Number counts every day, so the Gregorian side agrees
+// with IsLeapYear and DaysInMonth when walking the days of each year.
+func TestJulianDayGregorianLeapYears(t *testing.T) {
+	for y := 1500; y <= 2500; y++ {
+		jan1, _ := ToJulianDay(date(y, time.January, 1))
+		next, _ := ToJulianDay(date(y+1, time.January, 1))
+		want := int64(365)
+		if IsLeapYear(y) {
+			want = 366
+		}
+		if next-jan1 != want {
+			t.Fatalf("year %d has %d Julian Days, want %d", y, next-jan1, want)
+		}
+		mar1, _ := ToJulianDay(date(y, time.March, 1))
+		if got := mar1 - jan1 - 31; got != int64(DaysInMonth(y, time.February)) {
+			t.Fatalf("February %d has %d Julian Days, want %d", y, got, DaysInMonth(y, time.February))
+		}
+	}
+}
+
+func TestJulianLeapYears(t *testing.T) {
+	cases := []struct {
+		year     int
+		leap     bool
+		february int
+	}{
+		{1500, true, 29},
+		{1700, true, 29},
+		{1900, true, 29},
+		{2000, true, 29},
+		{2023, false, 28},
+		{0, true, 29},
+		{-1, false, 28},
+		{-4, true, 29},
+	}
+
+	for _, c := range cases {
+		if got := IsJulianLeapYear(c.year); got != c.leap {
+			t.Errorf("IsJulianLeapYear(%v) == %v, want %v", c.year, got, c.leap)
+		}
+		if got := DaysInJulianMonth(c.year, time.February); got != c.february {
+			t.Errorf("DaysInJulianMonth(%v, February) == %v, want %v", c.year, got, c.february)
+		}
+	}
+
+	if got := DaysInJulianMonth(1900, time.April); got != 30 {
+		t.Errorf("DaysInJulianMonth(1900, April) == %v, want 30", got)
+	}
+}
@@ -0,0 +1,341 @@
+package timex
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LeapSecond is a change of the difference between TAI and UTC.
+type LeapSecond struct {
+	// Time is the UTC instant the new difference applies from, the
+	// midnight following the inserted (or removed) second.
+	Time time.Time
+	// TAIMinusUTC is the difference in whole seconds from Time on.
+	TAIMinusUTC int
+}
+
+// LeapSecondTable is the list of leap seconds announced by the IERS.
+// The first entry is the 10 second difference UTC started with in 1972
+// and is not itself a leap second.
+//
+// The IERS announces leap seconds about six months ahead, so a table is
+// only known to be complete until Expires. Lookups and conversions use
+// the table as it is even after it has expired; callers that run for a
+// long time or ship a fixed table should check Expired and load a newer
+// list with ParseLeapSecondsList and SetLeapSecondTable.
+type LeapSecondTable struct {
+	LeapSeconds []LeapSecond // in time order
+	Updated     time.Time    // when the IERS last updated the list
+	Expires     time.Time    // the list is not valid after this
+}
+
+// Expired returns whether `now` is at or after Expires, when leap
+// seconds may have been announced that the table does not have. A table
+// without an expiry date never expires.
+func (lt *LeapSecondTable) Expired(now time.Time) bool {
+	return !lt.Expires.IsZero() && !now.Before(lt.Expires)
+}
+
+// TAIMinusUTC returns the difference between TAI and UTC at the UTC
+// instant `t`. Before 1972 UTC did not use leap seconds and the result
+// is 0.
+func (lt *LeapSecondTable) TAIMinusUTC(t time.Time) time.Duration {
+	ls := lt.LeapSeconds
+	for i := len(ls) - 1; i >= 0; i-- {
+		if !t.Before(ls[i].Time) {
+			return time.Duration(ls[i].TAIMinusUTC) * time.Second
+		}
+	}
+	return 0
+}
+
+// LeapSecondsBetween returns the number of leap seconds inserted between
+// the UTC instants `a` and `b`. It is negative if `b` is before `a`.
+func (lt *LeapSecondTable) LeapSecondsBetween(a, b time.Time) int {
+	if b.Before(a) {
+		return -lt.LeapSecondsBetween(b, a)
+	}
+	n := 0
+	for i := 1; i < len(lt.LeapSeconds); i++ {
+		ls := lt.LeapSeconds[i]
+		if ls.Time.After(a) && !ls.Time.After(b) {
+			n += ls.TAIMinusUTC - lt.LeapSeconds[i-1].TAIMinusUTC
+		}
+	}
+	return n
+}
+
+// UTCToTAI returns the TAI reading at the UTC instant `t`.
+func (lt *LeapSecondTable) UTCToTAI(t time.Time) time.Time {
+	return t.Add(lt.TAIMinusUTC(t))
+}
+
+// TAIToUTC returns the UTC instant of the TAI reading `t`. A reading
+// within an inserted leap second cannot be represented by time.Time and
+// is returned as the midnight that follows it.
+func (lt *LeapSecondTable) TAIToUTC(t time.Time) time.Time {
+	ls := lt.LeapSeconds
+	for i := len(ls) - 1; i >= 0; i-- {
+		offset := time.Duration(ls[i].TAIMinusUTC) * time.Second
+		if !t.Before(ls[i].Time.Add(offset)) {
+			return t.Add(-offset)
+		}
+		if i > 0 {
+			prev := time.Duration(ls[i-1].TAIMinusUTC) * time.Second
+			if !t.Before(ls[i].Time.Add(prev)) {
+				return ls[i].Time.In(t.Location())
+			}
+		}
+	}
+	return t
+}
+
+// ParseLeapSecondsList reads a table in the format of the IERS and NIST
+// leap-seconds.list file, which is also shipped with most time zone
+// databases. Timestamps in the file are NTP seconds. When the file
+// contains a "#h" hash line, the hash is verified.
+func ParseLeapSecondsList(r io.Reader) (*LeapSecondTable, error) {
+	lt := &LeapSecondTable{}
+	var hashed strings.Builder
+	var hash []string
+
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		switch {
+		case strings.HasPrefix(text, "#$"), strings.HasPrefix(text, "#@"):
+			fields := strings.Fields(text[2:])
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("timex: leap seconds line %d: missing timestamp", line)
+			}
+			t, err := parseNTPSeconds(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("timex: leap seconds line %d: %v", line, err)
+			}
+			if text[1] == '$' {
+				lt.Updated = t
+			} else {
+				lt.Expires = t
+			}
+			hashed.WriteString(fields[0])
+		case strings.HasPrefix(text, "#h"):
+			hash = strings.Fields(text[2:])
+		case strings.HasPrefix(text, "#"):
+			// comment
+		default:
+			if i := strings.IndexByte(text, '#'); i >= 0 {
+				text = text[:i]
+			}
+			fields := strings.Fields(text)
+			if len(fields) == 0 {
+				continue
+			}
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("timex: leap seconds line %d: want 2 fields, got %d", line, len(fields))
+			}
+			t, err := parseNTPSeconds(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("timex: leap seconds line %d: %v", line, err)
+			}
+			diff, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("timex: leap seconds line %d: %v", line, err)
+			}
+			if n := len(lt.LeapSeconds); n > 0 && !t.After(lt.LeapSeconds[n-1].Time) {
+				return nil, fmt.Errorf("timex: leap seconds line %d: entries are not in time order", line)
+			}
+			lt.LeapSeconds = append(lt.LeapSeconds, LeapSecond{Time: t, TAIMinusUTC: diff})
+			hashed.WriteString(fields[0])
+			hashed.WriteString(fields[1])
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(lt.LeapSeconds) == 0 {
+		return nil, fmt.Errorf("timex: leap seconds list has no entries")
+	}
+	if hash != nil && !leapSecondsHashMatches(hashed.String(), hash) {
+		return nil, fmt.Errorf("timex: leap seconds list hash does not match its contents")
+	}
+	return lt, nil
+}
+
+// leapSecondsHashMatches returns whether the SHA-1 of `data` matches the
+// five 32-bit words of `hash`. The IERS drops leading zeros from the
+// words, so they are compared as numbers.
+func leapSecondsHashMatches(data string, hash []string) bool {
+	sum := sha1.Sum([]byte(data))
+	if len(hash) != len(sum)/4 {
+		return false
+	}
+	for i, h := range hash {
+		w, err := strconv.ParseUint(h, 16, 32)
+		if err != nil || uint32(w) != binary.BigEndian.Uint32(sum[4*i:]) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNTPSeconds(s string) (time.Time, error) {
+	secs, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(secs+ntpEpoch, 0).UTC(), nil
+}
+
+var (
+	leapSecondsMu sync.RWMutex
+	leapSeconds   = embeddedLeapSeconds()
+)
+
+// CurrentLeapSecondTable returns the table used by the package level
+// functions. It starts as the IERS list built into the
+// package.
+func CurrentLeapSecondTable() *LeapSecondTable {
+	leapSecondsMu.RLock()
+	defer leapSecondsMu.RUnlock()
+	return leapSeconds
+}
+
+// SetLeapSecondTable replaces the table used by the package level
+// functions, usually with one read by ParseLeapSecondsList from a newer
+// leap-seconds.list. The table must not be modified afterwards.
+func SetLeapSecondTable(lt *LeapSecondTable) {
+	leapSecondsMu.Lock()
+	defer leapSecondsMu.Unlock()
+	leapSeconds = lt
+}
+
+// TAIMinusUTC returns the difference between TAI and UTC at the UTC
+// instant `t` using the current leap second table.
+func TAIMinusUTC(t time.Time) time.Duration {
+	return CurrentLeapSecondTable().TAIMinusUTC(t)
+}
+
+// LeapSecondsBetween returns the number of leap seconds inserted between
+// the UTC instants `a` and `b` using the current leap second table.
+func LeapSecondsBetween(a, b time.Time) int {
+	return CurrentLeapSecondTable().LeapSecondsBetween(a, b)
+}
+
+// TimeScale is a way of counting time. A time.Time converted to a scale
+// other than UTC holds the reading of a clock on that scale; it is not
+// the same instant as the original.
+type TimeScale int
+
+const (
+	// UTC is Coordinated Universal Time, the scale of time.Now.
+	UTC TimeScale = iota
+	// TAI is International Atomic Time. It has no leap seconds and is
+	// ahead of UTC by the seconds in the leap second table.
+	TAI
+	// GPS is the time scale of the GPS system. It runs 19 seconds behind
+	// TAI and matched UTC at the GPS epoch in 1980.
+	GPS
+	// TT is Terrestrial Time, used for astronomical ephemerides. It runs
+	// 32.184 seconds ahead of TAI.
+	TT
+)
+
+// offsets from TAI
+const (
+	gpsMinusTAI = -19 * time.Second
+	ttMinusTAI  = 32184 * time.Millisecond
+)
+
+var timeScaleNames = [...]string{UTC: "UTC", TAI: "TAI", GPS: "GPS", TT: "TT"}
+
+func (ts TimeScale) String() string {
+	if ts < 0 || int(ts) >= len(timeScaleNames) {
+		return "TimeScale(" + strconv.Itoa(int(ts)) + ")"
+	}
+	return timeScaleNames[ts]
+}
+
+// ConvertTimeScale returns the reading on the scale `to` for the reading
+// `t` on the scale `from`, using the current leap second table.
+func ConvertTimeScale(t time.Time, from, to TimeScale) time.Time {
+	lt := CurrentLeapSecondTable()
+
+	// convert to TAI
+	switch from {
+	case UTC:
+		t = lt.UTCToTAI(t)
+	case GPS:
+		t = t.Add(-gpsMinusTAI)
+	case TT:
+		t = t.Add(-ttMinusTAI)
+	}
+
+	switch to {
+	case UTC:
+		t = lt.TAIToUTC(t)
+	case GPS:
+		t = t.Add(gpsMinusTAI)
+	case TT:
+		t = t.Add(ttMinusTAI)
+	}
+	return t
+}
+
+// embeddedLeapSeconds returns the leap-seconds.list published by the IERS
+// on 2026-07-06, valid until 2027-06-28.
+func embeddedLeapSeconds() *LeapSecondTable {
+	entries := []struct {
+		y    int
+		m    time.Month
+		diff int
+	}{
+		{1972, time.January, 10},
+		{1972, time.July, 11},
+		{1973, time.January, 12},
+		{1974, time.January, 13},
+		{1975, time.January, 14},
+		{1976, time.January, 15},
+		{1977, time.January, 16},
+		{1978, time.January, 17},
+		{1979, time.January, 18},
+		{1980, time.January, 19},
+		{1981, time.July, 20},
+		{1982, time.July, 21},
+		{1983, time.July, 22},
+		{1985, time.July, 23},
+		{1988, time.January, 24},
+		{1990, time.January, 25},
+		{1991, time.January, 26},
+		{1992, time.July, 27},
+		{1993, time.July, 28},
+		{1994, time.July, 29},
+		{1996, time.January, 30},
+		{1997, time.July, 31},
+		{1999, time.January, 32},
+		{2006, time.January, 33},
+		{2009, time.January, 34},
+		{2012, time.July, 35},
+		{2015, time.July, 36},
+		{2017, time.January, 37},
+	}
+
+	lt := &LeapSecondTable{
+		Updated: time.Date(2026, time.July, 6, 0, 0, 0, 0, time.UTC),
+		Expires: time.Date(2027, time.June, 28, 0, 0, 0, 0, time.UTC),
+	}
+	for _, e := range entries {
+		lt.LeapSeconds = append(lt.LeapSeconds, LeapSecond{
+			Time:        time.Date(e.y, e.m, 1, 0, 0, 0, 0, time.UTC),
+			TAIMinusUTC: e.diff,
+		})
+	}
+	return lt
+}
@@ -0,0 +1,201 @@
+package timex_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+// leapSecondsList is an excerpt of the IERS leap-seconds.list with all
+// of the lines that are covered by its hash.
+const leapSecondsList = `#
+#	In the following text, the symbol '#' introduces
+#	a comment, which continues from that symbol until
+#	the end of the line.
+#
+#$	3992284800
+#@	4023129600
+2272060800      10      # 1 Jan 1972
+2287785600      11      # 1 Jul 1972
+2303683200      12      # 1 Jan 1973
+2335219200      13      # 1 Jan 1974
+2366755200      14      # 1 Jan 1975
+2398291200      15      # 1 Jan 1976
+2429913600      16      # 1 Jan 1977
+2461449600      17      # 1 Jan 1978
+2492985600      18      # 1 Jan 1979
+2524521600      19      # 1 Jan 1980
+2571782400      20      # 1 Jul 1981
+2603318400      21      # 1 Jul 1982
+2634854400      22      # 1 Jul 1983
+2698012800      23      # 1 Jul 1985
+2776982400      24      # 1 Jan 1988
+2840140800      25      # 1 Jan 1990
+2871676800      26      # 1 Jan 1991
+2918937600      27      # 1 Jul 1992
+2950473600      28      # 1 Jul 1993
+2982009600      29      # 1 Jul 1994
+3029443200      30      # 1 Jan 1996
+3076704000      31      # 1 Jul 1997
+3124137600      32      # 1 Jan 1999
+3345062400      33      # 1 Jan 2006
+3439756800      34      # 1 Jan 2009
+3550089600      35      # 1 Jul 2012
+3644697600      36      # 1 Jul 2015
+3692217600      37      # 1 Jan 2017
+#h	ae9c7fe a63be085 15bf660e 8fe336c2 69da28d8
+`
+
+func TestParseLeapSecondsList(t *testing.T) {
+	lt, err := ParseLeapSecondsList(strings.NewReader(leapSecondsList))
+	if err != nil {
+		t.Fatalf("ParseLeapSecondsList returned error %v", err)
+	}
+
+	embedded := CurrentLeapSecondTable()
+	if len(lt.LeapSeconds) != len(embedded.LeapSeconds) {
+		t.Fatalf("ParseLeapSecondsList read %d entries, want %d", len(lt.LeapSeconds), len(embedded.LeapSeconds))
+	}
+	for i, ls := range lt.LeapSeconds {
+		if ls != embedded.LeapSeconds[i] {
+			t.Errorf("entry %d == %v, want %v", i, ls, embedded.LeapSeconds[i])
+		}
+	}
+	if lt.Updated != embedded.Updated || lt.Expires != embedded.Expires {
+		t.Errorf("Updated, Expires == %v, %v, want %v, %v", lt.Updated, lt.Expires, embedded.Updated, embedded.Expires)
+	}
+
+	bad := []string{
+		"",
+		"2272060800 ten\n",
+		"2272060800 10 11\n",
+		"2287785600 11\n2272060800 10\n",
+		strings.Replace(leapSecondsList, "37      # 1 Jan 2017", "38      # 1 Jan 2017", 1),
+	}
+	for _, b := range bad {
+		if _, err := ParseLeapSecondsList(strings.NewReader(b)); err == nil {
+			t.Errorf("ParseLeapSecondsList(%q) returned no error", b)
+		}
+	}
+}
+
+func TestTAIMinusUTC(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected time.Duration
+	}{
+		{date(1971, time.December, 31), 0},
+		{date(1972, time.January, 1), 10 * time.Second},
+		{time.Date(2016, time.December, 31, 23, 59, 59, 999999999, utc), 36 * time.Second},
+		{date(2017, time.January, 1), 37 * time.Second},
+		{date(2026, time.January, 1), 37 * time.Second},
+	}
+
+	for _, c := range cases {
+		got := TAIMinusUTC(c.t)
+		if got != c.expected {
+			t.Errorf("TAIMinusUTC(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestLeapSecondsBetween(t *testing.T) {
+	cases := []struct {
+		a, b     time.Time
+		expected int
+	}{
+		{date(1960, time.January, 1), date(1972, time.June, 1), 0},
+		{date(1972, time.January, 1), date(2017, time.January, 1), 27},
+		{date(1980, time.January, 6), date(2026, time.January, 1), 18},
+		{date(2015, time.June, 30), date(2015, time.July, 1), 1},
+		{date(2015, time.July, 1), date(2015, time.July, 2), 0},
+		{date(2017, time.January, 1), date(2012, time.January, 1), -3},
+	}
+
+	for _, c := range cases {
+		got := LeapSecondsBetween(c.a, c.b)
+		if got != c.expected {
+			t.Errorf("LeapSecondsBetween(%v, %v) == %d, want %d", c.a, c.b, got, c.expected)
+		}
+	}
+}
+
+func TestConvertTimeScale(t *testing.T) {
+	utcTime := time.Date(2015, time.July, 1, 12, 0, 0, 0, utc)
+
+	cases := []struct {
+		t        time.Time
+		from, to TimeScale
+		expected time.Time
+	}{
+		{utcTime, UTC, TAI, utcTime.Add(36 * time.Second)},
+		{utcTime, UTC, GPS, utcTime.Add(17 * time.Second)},
+		{utcTime, UTC, TT, utcTime.Add(68184 * time.Millisecond)},
+		{utcTime.Add(36 * time.Second), TAI, UTC, utcTime},
+		{utcTime.Add(17 * time.Second), GPS, UTC, utcTime},
+		{utcTime.Add(68184 * time.Millisecond), TT, UTC, utcTime},
+		{utcTime, GPS, TAI, utcTime.Add(19 * time.Second)},
+		{utcTime, TAI, TT, utcTime.Add(32184 * time.Millisecond)},
+		// GPS matched UTC at its epoch
+		{date(1980, time.January, 6), UTC, GPS, date(1980, time.January, 6)},
+		// the TAI readings of the leap second at the end of June 2015
+		{time.Date(2015, time.July, 1, 0, 0, 34, 0, utc), TAI, UTC, time.Date(2015, time.June, 30, 23, 59, 59, 0, utc)},
+		{time.Date(2015, time.July, 1, 0, 0, 35, 0, utc), TAI, UTC, date(2015, time.July, 1)},
+		{time.Date(2015, time.July, 1, 0, 0, 35, 5e8, utc), TAI, UTC, date(2015, time.July, 1)},
+		{time.Date(2015, time.July, 1, 0, 0, 36, 0, utc), TAI, UTC, date(2015, time.July, 1)},
+		{time.Date(2015, time.July, 1, 0, 0, 37, 0, utc), TAI, UTC, time.Date(2015, time.July, 1, 0, 0, 1, 0, utc)},
+	}
+
+	for _, c := range cases {
+		got := ConvertTimeScale(c.t, c.from, c.to)
+		if got != c.expected {
+			t.Errorf("ConvertTimeScale(%v, %s, %s) == %v, want %v", c.t, c.from, c.to, got, c.expected)
+		}
+	}
+}
+
+func TestSetLeapSecondTable(t *testing.T) {
+	old := CurrentLeapSecondTable()
+	defer SetLeapSecondTable(old)
+
+	// a hypothetical negative leap second at the end of 2030
+	lt := *old
+	lt.LeapSeconds = append(lt.LeapSeconds[:len(lt.LeapSeconds):len(lt.LeapSeconds)], LeapSecond{
+		Time:        date(2031, time.January, 1),
+		TAIMinusUTC: 36,
+	})
+	SetLeapSecondTable(&lt)
+
+	if got := LeapSecondsBetween(date(2030, time.January, 1), date(2032, time.January, 1)); got != -1 {
+		t.Errorf("LeapSecondsBetween with a negative leap second == %d, want -1", got)
+	}
+	if got := TAIMinusUTC(date(2031, time.June, 1)); got != 36*time.Second {
+		t.Errorf("TAIMinusUTC(2031-06-01) == %v, want 36s", got)
+	}
+	if got := len(old.LeapSeconds); got != 28 {
+		t.Errorf("SetLeapSecondTable modified the previous table, it has %d entries", got)
+	}
+}
+
+func TestLeapSecondTableExpired(t *testing.T) {
+	lt := &LeapSecondTable{Expires: date(2027, time.June, 28)}
+	cases := []struct {
+		now      time.Time
+		expected bool
+	}{
+		{date(2027, time.June, 27), false},
+		{date(2027, time.June, 28), true},
+		{date(2030, time.January, 1), true},
+	}
+
+	for _, c := range cases {
+		if got := lt.Expired(c.now); got != c.expected {
+			t.Errorf("Expired(%v) == %v, want %v", c.now, got, c.expected)
+		}
+	}
+	if (&LeapSecondTable{}).Expired(date(2030, time.January, 1)) {
+		t.Errorf("a table without an expiry date expired")
+	}
+}
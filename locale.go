@@ -0,0 +1,338 @@
+package timex
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale holds the calendar conventions of a language or region: the
+// names of months and weekdays, which day a week starts on, and which
+// days make up the weekend.
+type Locale struct {
+	Name          string // a BCP 47 tag such as "en-US" or "de"
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string // indexed by time.Weekday
+	ShortWeekdays [7]string // indexed by time.Weekday
+
+	// NarrowWeekdays are the names of one or two characters used in
+	// the headers of calendars, indexed by time.Weekday.
+	NarrowWeekdays [7]string
+
+	FirstDayOfWeek time.Weekday
+	Weekend        []time.Weekday
+
+	// MinDaysInFirstWeek is the number of days of a new year the first
+	// week of the year must contain. See WeekOfYear.
+	MinDaysInFirstWeek int
+}
+
+// MonthName returns the full localized name of `m`.
+func (l *Locale) MonthName(m time.Month) string {
+	return l.Months[m-1]
+}
+
+// ShortMonthName returns the abbreviated localized name of `m`.
+func (l *Locale) ShortMonthName(m time.Month) string {
+	return l.ShortMonths[m-1]
+}
+
+// WeekdayName returns the full localized name of `w`.
+func (l *Locale) WeekdayName(w time.Weekday) string {
+	return l.Weekdays[w]
+}
+
+// ShortWeekdayName returns the abbreviated localized name of `w`.
+func (l *Locale) ShortWeekdayName(w time.Weekday) string {
+	return l.ShortWeekdays[w]
+}
+
+// NarrowWeekdayName returns the narrow localized name of `w`. When the
+// locale has no narrow names the first two characters of the
+// abbreviated name are used.
+func (l *Locale) NarrowWeekdayName(w time.Weekday) string {
+	if name := l.NarrowWeekdays[w]; name != "" {
+		return name
+	}
+	name := []rune(l.ShortWeekdays[w])
+	if len(name) > 2 {
+		name = name[:2]
+	}
+	return string(name)
+}
+
+// IsWeekend returns whether `w` is a weekend day in the locale.
+func (l *Locale) IsWeekend(w time.Weekday) bool {
+	for _, we := range l.Weekend {
+		if we == w {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{}
+)
+
+// RegisterLocale adds `l` to the registry under `l.Name`, replacing any
+// locale already registered with that name.
+func RegisterLocale(l *Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[canonicalLocaleName(l.Name)] = l
+}
+
+// LookupLocale returns the registered locale for `name`. If there is no
+// exact match, the language alone is tried, so "de-AT" finds "de".
+func LookupLocale(name string) (*Locale, bool) {
+	name = canonicalLocaleName(name)
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	if l, ok := locales[name]; ok {
+		return l, true
+	}
+	if i := strings.IndexByte(name, '-'); i > 0 {
+		if l, ok := locales[name[:i]]; ok {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+func canonicalLocaleName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "-", -1))
+}
+
+// Format returns a textual representation of `t` using a time.Format
+// layout. The month and weekday names ("January", "Jan", "Monday",
+// "Mon") are replaced with the names from `l`. A nil locale formats
+// exactly like t.Format.
+func Format(t time.Time, layout string, l *Locale) string {
+	if l == nil {
+		return t.Format(layout)
+	}
+
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(layout); {
+		name, n := localizedChunk(t, layout[i:], l)
+		if n == 0 {
+			i++
+			continue
+		}
+		if start < i {
+			b.WriteString(t.Format(layout[start:i]))
+		}
+		b.WriteString(name)
+		i += n
+		start = i
+	}
+	if start < len(layout) {
+		b.WriteString(t.Format(layout[start:]))
+	}
+	return b.String()
+}
+
+// localizedChunk returns the localized name for the layout element at
+// the start of `layout` and its length, or 0 if there is none. Like the
+// time package, "Jan" and "Mon" are not elements when a lower case
+// letter follows, as in "Monthly".
+func localizedChunk(t time.Time, layout string, l *Locale) (string, int) {
+	switch {
+	case strings.HasPrefix(layout, "January"):
+		return l.MonthName(t.Month()), 7
+	case strings.HasPrefix(layout, "Jan") && !startsWithLowerCase(layout[3:]):
+		return l.ShortMonthName(t.Month()), 3
+	case strings.HasPrefix(layout, "Monday"):
+		return l.WeekdayName(t.Weekday()), 6
+	case strings.HasPrefix(layout, "Mon") && !startsWithLowerCase(layout[3:]):
+		return l.ShortWeekdayName(t.Weekday()), 3
+	}
+	return "", 0
+}
+
+// startsWithLowerCase returns whether `s` begins with a lower case ASCII
+// letter.
+func startsWithLowerCase(s string) bool {
+	return len(s) > 0 && 'a' <= s[0] && s[0] <= 'z'
+}
+
+var (
+	satSun = []time.Weekday{time.Saturday, time.Sunday}
+
+	englishMonths = [12]string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	englishShortMonths = [12]string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	}
+	englishWeekdays = [7]string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	}
+	englishShortWeekdays = [7]string{
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+	}
+	englishNarrowWeekdays = [7]string{
+		"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa",
+	}
+)
+
+// The locales registered by default.
+var (
+	EnglishUS = &Locale{
+		Name:               "en-US",
+		Months:             englishMonths,
+		ShortMonths:        englishShortMonths,
+		Weekdays:           englishWeekdays,
+		ShortWeekdays:      englishShortWeekdays,
+		NarrowWeekdays:     englishNarrowWeekdays,
+		FirstDayOfWeek:     time.Sunday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 1,
+	}
+
+	EnglishGB = &Locale{
+		Name:               "en-GB",
+		Months:             englishMonths,
+		ShortMonths:        englishShortMonths,
+		Weekdays:           englishWeekdays,
+		ShortWeekdays:      englishShortWeekdays,
+		NarrowWeekdays:     englishNarrowWeekdays,
+		FirstDayOfWeek:     time.Monday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 4,
+	}
+
+	German = &Locale{
+		Name: "de",
+		Months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		ShortMonths: [12]string{
+			"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+			"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+		},
+		Weekdays: [7]string{
+			"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+		},
+		ShortWeekdays: [7]string{
+			"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
+		},
+		NarrowWeekdays: [7]string{
+			"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
+		},
+		FirstDayOfWeek:     time.Monday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 4,
+	}
+
+	French = &Locale{
+		Name: "fr",
+		Months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		ShortMonths: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		Weekdays: [7]string{
+			"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+		},
+		ShortWeekdays: [7]string{
+			"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam.",
+		},
+		NarrowWeekdays: [7]string{
+			"di", "lu", "ma", "me", "je", "ve", "sa",
+		},
+		FirstDayOfWeek:     time.Monday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 4,
+	}
+
+	Spanish = &Locale{
+		Name: "es",
+		Months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		ShortMonths: [12]string{
+			"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic",
+		},
+		Weekdays: [7]string{
+			"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+		},
+		ShortWeekdays: [7]string{
+			"dom", "lun", "mar", "mié", "jue", "vie", "sáb",
+		},
+		NarrowWeekdays: [7]string{
+			"do", "lu", "ma", "mi", "ju", "vi", "sá",
+		},
+		FirstDayOfWeek:     time.Monday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 4,
+	}
+
+	Japanese = &Locale{
+		Name: "ja",
+		Months: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		ShortMonths: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		Weekdays: [7]string{
+			"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+		},
+		ShortWeekdays: [7]string{
+			"日", "月", "火", "水", "木", "金", "土",
+		},
+		NarrowWeekdays: [7]string{
+			"日", "月", "火", "水", "木", "金", "土",
+		},
+		FirstDayOfWeek:     time.Sunday,
+		Weekend:            satSun,
+		MinDaysInFirstWeek: 1,
+	}
+
+	Arabic = &Locale{
+		Name: "ar",
+		Months: [12]string{
+			"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+			"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+		},
+		ShortMonths: [12]string{
+			"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو",
+			"يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر",
+		},
+		Weekdays: [7]string{
+			"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت",
+		},
+		ShortWeekdays: [7]string{
+			"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت",
+		},
+		NarrowWeekdays: [7]string{
+			"ح", "ن", "ث", "ر", "خ", "ج", "س",
+		},
+		FirstDayOfWeek:     time.Saturday,
+		Weekend:            []time.Weekday{time.Friday, time.Saturday},
+		MinDaysInFirstWeek: 1,
+	}
+)
+
+func init() {
+	for _, l := range []*Locale{EnglishUS, EnglishGB, German, French, Spanish, Japanese, Arabic} {
+		RegisterLocale(l)
+	}
+	locales["en"] = EnglishUS
+}
@@ -0,0 +1,131 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestLookupLocale(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected *Locale
+	}{
+		{"en", EnglishUS},
+		{"en-US", EnglishUS},
+		{"en_gb", EnglishGB},
+		{"de", German},
+		{"de-AT", German},
+		{"fr-CA", French},
+		{"ja-JP", Japanese},
+		{"ar-SA", Arabic},
+		{"xx", nil},
+	}
+
+	for _, c := range cases {
+		got, ok := LookupLocale(c.name)
+		if got != c.expected || ok != (c.expected != nil) {
+			t.Errorf("LookupLocale(%q) == %v, %t, want %v", c.name, got, ok, c.expected)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	pirate := *EnglishUS
+	pirate.Name = "en-PIRATE"
+	pirate.Weekdays[time.Friday] = "Fryday"
+	RegisterLocale(&pirate)
+
+	got, ok := LookupLocale("en-pirate")
+	if !ok || got.WeekdayName(time.Friday) != "Fryday" {
+		t.Errorf("LookupLocale(%q) == %v, %t, want registered locale", "en-pirate", got, ok)
+	}
+	if EnglishUS.WeekdayName(time.Friday) != "Friday" {
+		t.Errorf("registering a copy modified EnglishUS")
+	}
+}
+
+func TestNarrowWeekdayName(t *testing.T) {
+	custom := Locale{ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}}
+
+	cases := []struct {
+		l        *Locale
+		w        time.Weekday
+		expected string
+	}{
+		{EnglishUS, time.Thursday, "Th"},
+		{French, time.Monday, "lu"},
+		{Japanese, time.Monday, "月"},
+		{Arabic, time.Monday, "ن"},
+		{&custom, time.Wednesday, "We"},
+	}
+
+	for _, c := range cases {
+		if got := c.l.NarrowWeekdayName(c.w); got != c.expected {
+			t.Errorf("NarrowWeekdayName(%v) == %q, want %q", c.w, got, c.expected)
+		}
+	}
+}
+
+func TestLocaleWeek(t *testing.T) {
+	cases := []struct {
+		l        *Locale
+		first    time.Weekday
+		weekend  time.Weekday
+		workweek time.Weekday
+	}{
+		{EnglishUS, time.Sunday, time.Saturday, time.Friday},
+		{German, time.Monday, time.Sunday, time.Friday},
+		{Arabic, time.Saturday, time.Friday, time.Sunday},
+	}
+
+	for _, c := range cases {
+		if c.l.FirstDayOfWeek != c.first {
+			t.Errorf("%s.FirstDayOfWeek == %s, want %s", c.l.Name, c.l.FirstDayOfWeek, c.first)
+		}
+		if !c.l.IsWeekend(c.weekend) {
+			t.Errorf("%s.IsWeekend(%s) == false, want true", c.l.Name, c.weekend)
+		}
+		if c.l.IsWeekend(c.workweek) {
+			t.Errorf("%s.IsWeekend(%s) == true, want false", c.l.Name, c.workweek)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tm := time.Date(2015, time.March, 4, 15, 4, 5, 0, utc)
+
+	cases := []struct {
+		layout   string
+		l        *Locale
+		expected string
+	}{
+		{time.RFC1123, nil, "Wed, 04 Mar 2015 15:04:05 UTC"},
+		{time.RFC1123, EnglishUS, "Wed, 04 Mar 2015 15:04:05 UTC"},
+		{"Monday, 2. January 2006", German, "Mittwoch, 4. März 2015"},
+		{"Mon 2 Jan 2006 15:04", French, "mer. 4 mars 2015 15:04"},
+		{"Monday 2 de January de 2006", Spanish, "miércoles 4 de marzo de 2015"},
+		{"2006年January2日 Monday", Japanese, "2015年3月4日 水曜日"},
+		{"Monday، 2 January 2006", Arabic, "الأربعاء، 4 مارس 2015"},
+		// "Jan" and "Mon" followed by a lower case letter are not elements
+		{"Monthly, Janus 2", German, "Monthly, Janus 4"},
+		{"Monthly, Jan. 2", German, "Monthly, Mär. 4"},
+		{"Mon Monx", French, "mer. Monx"},
+		{"MonJan", French, "mer.mars"},
+	}
+
+	for _, c := range cases {
+		got := Format(tm, c.layout, c.l)
+		if got != c.expected {
+			t.Errorf("Format(%v, %q, %v) == %q, want %q", tm, c.layout, c.l, got, c.expected)
+		}
+	}
+
+	// English agrees with time.Format
+	for _, layout := range []string{"Monthly", "Janus", "Mon Monx Jan Janx", "MonJanuary"} {
+		if got, expected := Format(tm, layout, EnglishUS), tm.Format(layout); got != expected {
+			t.Errorf("Format(%v, %q, EnglishUS) == %q, want %q", tm, layout, got, expected)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package persian
+
+import (
+	"time"
+
+	"github.com/justrudd/timex"
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Calendar is the Persian calendar as a timex.Calendar, for use with its
+// generic adjusters.
+var Calendar timex.Calendar = calendar{}
+
+type calendar struct{}
+
+func (calendar) Date(t time.Time) (int, time.Month, int) {
+	d := FromTime(t)
+	return d.Year, time.Month(d.Month), d.Day
+}
+
+func (calendar) Time(year int, month time.Month, day int, loc *time.Location) time.Time {
+	n := epoch + newYear(year) + int64(dayOfYear(Month(month))+day-1)
+	return calendrical.FromJDN(n, loc)
+}
+
+func (calendar) IsLeapYear(year int) bool {
+	return IsLeapYear(year)
+}
+
+func (calendar) MonthsInYear(year int) int {
+	return 12
+}
+
+func (calendar) DaysInMonth(year int, month time.Month) int {
+	return DaysInMonth(year, Month(month))
+}
+
+func (calendar) String() string { return "Persian" }
@@ -0,0 +1,212 @@
+// Package persian converts between time.Time and dates of the Persian
+// (Solar Hijri) calendar, the official calendar of Iran and
+// Afghanistan.
+//
+// The year begins at the March equinox. The first six months have 31
+// days, the next five 30, and Esfand has 29 days, or 30 in a leap year.
+// Officially a year is a leap year when the equinox that ends it falls
+// so as to delay the next Nowruz. This package uses the 33 year cycle of
+// 8 leap years, corrected up to the year 3000 (3621) for the years where
+// the cycle puts the leap day a year early.
+package persian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Month is a month of the Persian calendar.
+type Month int
+
+// The months of the Persian calendar.
+const (
+	Farvardin Month = 1 + iota
+	Ordibehesht
+	Khordad
+	Tir
+	Mordad
+	Shahrivar
+	Mehr
+	Aban
+	Azar
+	Dey
+	Bahman
+	Esfand
+)
+
+var monthNames = [...]string{
+	Farvardin:   "Farvardin",
+	Ordibehesht: "Ordibehesht",
+	Khordad:     "Khordad",
+	Tir:         "Tir",
+	Mordad:      "Mordad",
+	Shahrivar:   "Shahrivar",
+	Mehr:        "Mehr",
+	Aban:        "Aban",
+	Azar:        "Azar",
+	Dey:         "Dey",
+	Bahman:      "Bahman",
+	Esfand:      "Esfand",
+}
+
+var nativeMonthNames = [...]string{
+	Farvardin:   "فروردین",
+	Ordibehesht: "اردیبهشت",
+	Khordad:     "خرداد",
+	Tir:         "تیر",
+	Mordad:      "مرداد",
+	Shahrivar:   "شهریور",
+	Mehr:        "مهر",
+	Aban:        "آبان",
+	Azar:        "آذر",
+	Dey:         "دی",
+	Bahman:      "بهمن",
+	Esfand:      "اسفند",
+}
+
+// String returns the name of the month transliterated into English.
+func (m Month) String() string {
+	if m < Farvardin || m > Esfand {
+		return "Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return monthNames[m]
+}
+
+// Native returns the name of the month in Persian.
+func (m Month) Native() string {
+	if m < Farvardin || m > Esfand {
+		return m.String()
+	}
+	return nativeMonthNames[m]
+}
+
+// Date is a date in the Persian calendar. Years are counted from the
+// Hijra; 1403 began in March 2024.
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// FromTime returns the Persian date of the civil date of `t`.
+func FromTime(t time.Time) Date {
+	days := calendrical.JDN(t) - epoch
+	year := int(1 + calendrical.FloorDiv(33*days+3, 12053))
+	if days < newYear(year) {
+		year--
+	} else if days >= newYear(year+1) {
+		year++
+	}
+	yday := int(days - newYear(year))
+	month := Month(yday/31) + 1
+	if yday >= 6*31 {
+		month = Month((yday-6)/30) + 1
+	}
+	return Date{year, month, yday - dayOfYear(month) + 1}
+}
+
+// Time returns a new time.Time at midnight in `loc` on the civil date
+// that corresponds to the Persian date. An error is returned for months
+// and days that do not exist in the year.
+func (d Date) Time(loc *time.Location) (time.Time, error) {
+	if !d.IsValid() {
+		return time.Time{}, fmt.Errorf("persian: invalid date %v", d)
+	}
+	n := epoch + newYear(d.Year) + int64(dayOfYear(d.Month)+d.Day-1)
+	return calendrical.FromJDN(n, loc), nil
+}
+
+// IsValid returns whether the month and day exist in the year.
+func (d Date) IsValid() bool {
+	return d.Month >= Farvardin && d.Month <= Esfand && d.Day >= 1 && d.Day <= DaysInMonth(d.Year, d.Month)
+}
+
+// String returns the date as in "1 Farvardin 1403".
+func (d Date) String() string {
+	return strconv.Itoa(d.Day) + " " + d.Month.String() + " " + strconv.Itoa(d.Year)
+}
+
+// Native returns the date in Persian with Persian digits, as in
+// "۱ فروردین ۱۴۰۳".
+func (d Date) Native() string {
+	return digits(d.Day) + " " + d.Month.Native() + " " + digits(d.Year)
+}
+
+// IsLeapYear returns whether Esfand of `year` has 30 days.
+func IsLeapYear(year int) bool {
+	if delayed[year] {
+		return false
+	}
+	return calendrical.Mod(25*year+11, 33) < 8 || delayed[year-1]
+}
+
+// DaysInMonth returns the number of days in month `m` of `year`.
+func DaysInMonth(year int, m Month) int {
+	switch {
+	case m <= Shahrivar:
+		return 31
+	case m < Esfand || IsLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+// DaysInYear returns 366 for leap years and 365 otherwise.
+func DaysInYear(year int) int {
+	if IsLeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// epoch is the Julian Day Number of 1 Farvardin 1.
+const epoch = 1948320
+
+// delayed holds the years the 33 year cycle makes leap years where the
+// leap day belongs to the following year instead.
+var delayed = map[int]bool{}
+
+func init() {
+	for _, year := range []int{
+		1502, 1601, 1634, 1667, 1700, 1733, 1766, 1799, 1832, 1865, 1898, 1931,
+		1964, 1997, 2030, 2059, 2063, 2096, 2129, 2158, 2162, 2191, 2195, 2224,
+		2228, 2257, 2261, 2290, 2294, 2323, 2327, 2356, 2360, 2389, 2393, 2422,
+		2426, 2455, 2459, 2488, 2492, 2521, 2525, 2554, 2558, 2587, 2591, 2620,
+		2624, 2653, 2657, 2686, 2690, 2719, 2723, 2748, 2752, 2756, 2781, 2785,
+		2789, 2818, 2822, 2847, 2851, 2855, 2880, 2884, 2888, 2913, 2917, 2921,
+		2946, 2950, 2954, 2979, 2983, 2987,
+	} {
+		delayed[year] = true
+	}
+}
+
+// newYear returns the days from the epoch to 1 Farvardin of `year`.
+func newYear(year int) int64 {
+	n := 365*int64(year-1) + calendrical.FloorDiv(8*int64(year)+21, 33)
+	if delayed[year-1] {
+		n--
+	}
+	return n
+}
+
+// dayOfYear returns the days from 1 Farvardin to the first of `m`.
+func dayOfYear(m Month) int {
+	if m <= Mehr {
+		return 31 * int(m-1)
+	}
+	return 30*int(m-1) + 6
+}
+
+// digits formats `n` with the Persian digits ۰ to ۹.
+func digits(n int) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '۰' + r - '0'
+		}
+		return r
+	}, strconv.Itoa(n))
+}
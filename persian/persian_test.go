@@ -0,0 +1,107 @@
+package persian_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/persian"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFromTime(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected Date
+	}{
+		{date(1979, time.February, 11), Date{1357, Bahman, 22}},
+		{date(2000, time.January, 1), Date{1378, Dey, 11}},
+		{date(2024, time.March, 19), Date{1402, Esfand, 29}},
+		{date(2024, time.March, 20), Date{1403, Farvardin, 1}},
+		{date(2024, time.September, 22), Date{1403, Mehr, 1}},
+		{date(2024, time.December, 21), Date{1403, Dey, 1}},
+		{date(2025, time.March, 20), Date{1403, Esfand, 30}},
+		{date(2025, time.March, 21), Date{1404, Farvardin, 1}},
+		{date(2124, time.March, 20), Date{1503, Farvardin, 1}},
+		{time.Date(2024, time.March, 20, 23, 0, 0, 0, time.FixedZone("IRST", 7*30*60)), Date{1403, Farvardin, 1}},
+	}
+
+	for _, c := range cases {
+		got := FromTime(c.t)
+		if got != c.expected {
+			t.Errorf("FromTime(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	for d := date(1900, time.January, 1); d.Year() <= 2100; d = d.AddDate(0, 0, 1) {
+		p := FromTime(d)
+		got, err := p.Time(time.UTC)
+		if err != nil || !got.Equal(d) {
+			t.Fatalf("%v.Time(UTC) == %v, %v, want %v", p, got, err, d)
+		}
+	}
+
+	for _, d := range []Date{
+		{1402, Esfand, 30},
+		{1403, Mehr, 31},
+		{1403, Month(13), 1},
+		{1403, Farvardin, 0},
+	} {
+		if got, err := d.Time(time.UTC); err == nil {
+			t.Errorf("%v.Time(UTC) == %v, want an error", d, got)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	cases := []struct {
+		year     int
+		expected bool
+	}{
+		{1399, true},
+		{1400, false},
+		{1403, true},
+		{1404, false},
+		{1408, true},
+		{1502, false},
+		{1503, true},
+	}
+
+	for _, c := range cases {
+		if got := IsLeapYear(c.year); got != c.expected {
+			t.Errorf("IsLeapYear(%v) == %v, want %v", c.year, got, c.expected)
+		}
+		sum := 0
+		for m := Farvardin; m <= Esfand; m++ {
+			sum += DaysInMonth(c.year, m)
+		}
+		if sum != DaysInYear(c.year) {
+			t.Errorf("months of %v have %v days, want %v", c.year, sum, DaysInYear(c.year))
+		}
+	}
+}
+
+func TestDateString(t *testing.T) {
+	d := Date{1403, Farvardin, 1}
+	if got, expected := d.String(), "1 Farvardin 1403"; got != expected {
+		t.Errorf("String() == %v, want %v", got, expected)
+	}
+	if got, expected := d.Native(), "۱ فروردین ۱۴۰۳"; got != expected {
+		t.Errorf("Native() == %v, want %v", got, expected)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	d := date(2025, time.March, 10)
+	year, month, day := Calendar.Date(d)
+	if y, m, dd := 1403, 12, 20; year != y || int(month) != m || day != dd {
+		t.Errorf("Calendar.Date(%v) == %v, %v, %v, want %v, %v, %v", d, year, int(month), day, y, m, dd)
+	}
+	if got := Calendar.Time(year, month, day, time.UTC); !got.Equal(d) {
+		t.Errorf("Calendar.Time(%v, %v, %v, UTC) == %v, want %v", year, int(month), day, got, d)
+	}
+}
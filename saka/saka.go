@@ -0,0 +1,182 @@
+// Package saka converts between time.Time and dates of the Indian
+// National Calendar, the Saka calendar used alongside the Gregorian
+// calendar by the Government of India.
+//
+// The calendar is fixed to the Gregorian: the year begins on March 22nd,
+// or March 21st in a Gregorian leap year, and is a leap year when the
+// Gregorian year in which it begins is. Chaitra has 30 days, or 31 in a
+// leap year, the next five months 31 and the last six 30.
+package saka
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justrudd/timex"
+	"github.com/justrudd/timex/internal/calendrical"
+)
+
+// Month is a month of the Saka calendar.
+type Month int
+
+// The months of the Saka calendar.
+const (
+	Chaitra Month = 1 + iota
+	Vaishakha
+	Jyaishtha
+	Ashadha
+	Shravana
+	Bhadra
+	Ashvin
+	Kartika
+	Agrahayana
+	Pausha
+	Magha
+	Phalguna
+)
+
+var monthNames = [...]string{
+	Chaitra:    "Chaitra",
+	Vaishakha:  "Vaishakha",
+	Jyaishtha:  "Jyaishtha",
+	Ashadha:    "Ashadha",
+	Shravana:   "Shravana",
+	Bhadra:     "Bhadra",
+	Ashvin:     "Ashvin",
+	Kartika:    "Kartika",
+	Agrahayana: "Agrahayana",
+	Pausha:     "Pausha",
+	Magha:      "Magha",
+	Phalguna:   "Phalguna",
+}
+
+var nativeMonthNames = [...]string{
+	Chaitra:    "चैत्र",
+	Vaishakha:  "वैशाख",
+	Jyaishtha:  "ज्येष्ठ",
+	Ashadha:    "आषाढ़",
+	Shravana:   "श्रावण",
+	Bhadra:     "भाद्र",
+	Ashvin:     "आश्विन",
+	Kartika:    "कार्तिक",
+	Agrahayana: "अग्रहायण",
+	Pausha:     "पौष",
+	Magha:      "माघ",
+	Phalguna:   "फाल्गुन",
+}
+
+// String returns the name of the month transliterated into English.
+func (m Month) String() string {
+	if m < Chaitra || m > Phalguna {
+		return "Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return monthNames[m]
+}
+
+// Native returns the name of the month in Hindi.
+func (m Month) Native() string {
+	if m < Chaitra || m > Phalguna {
+		return m.String()
+	}
+	return nativeMonthNames[m]
+}
+
+// Date is a date in the Saka calendar. Years are counted from the Saka
+// era, 78 years after the Gregorian; 1946 began in March 2024.
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// FromTime returns the Saka date of the civil date of `t`.
+func FromTime(t time.Time) Date {
+	n := calendrical.JDN(t)
+	year := t.Year() - 78
+	if n < newYear(year) {
+		year--
+	}
+	yday := int(n - newYear(year))
+	month := Chaitra
+	for yday >= DaysInMonth(year, month) {
+		yday -= DaysInMonth(year, month)
+		month++
+	}
+	return Date{year, month, yday + 1}
+}
+
+// Time returns a new time.Time at midnight in `loc` on the civil date
+// that corresponds to the Saka date. An error is returned for months and
+// days that do not exist in the year.
+func (d Date) Time(loc *time.Location) (time.Time, error) {
+	if !d.IsValid() {
+		return time.Time{}, fmt.Errorf("saka: invalid date %v", d)
+	}
+	n := newYear(d.Year) + int64(d.Day) - 1
+	for m := Chaitra; m < d.Month; m++ {
+		n += int64(DaysInMonth(d.Year, m))
+	}
+	return calendrical.FromJDN(n, loc), nil
+}
+
+// IsValid returns whether the month and day exist in the year.
+func (d Date) IsValid() bool {
+	return d.Month >= Chaitra && d.Month <= Phalguna && d.Day >= 1 && d.Day <= DaysInMonth(d.Year, d.Month)
+}
+
+// String returns the date as in "1 Chaitra 1946".
+func (d Date) String() string {
+	return strconv.Itoa(d.Day) + " " + d.Month.String() + " " + strconv.Itoa(d.Year)
+}
+
+// Native returns the date in Hindi with Devanagari digits, as in
+// "१ चैत्र १९४६".
+func (d Date) Native() string {
+	return digits(d.Day) + " " + d.Month.Native() + " " + digits(d.Year)
+}
+
+// IsLeapYear returns whether Chaitra of `year` has 31 days, which is
+// when the Gregorian year `year` + 78 is a leap year.
+func IsLeapYear(year int) bool {
+	return timex.IsLeapYear(year + 78)
+}
+
+// DaysInMonth returns the number of days in month `m` of `year`.
+func DaysInMonth(year int, m Month) int {
+	switch {
+	case m == Chaitra && IsLeapYear(year):
+		return 31
+	case m == Chaitra || m >= Ashvin:
+		return 30
+	}
+	return 31
+}
+
+// DaysInYear returns 366 for leap years and 365 otherwise.
+func DaysInYear(year int) int {
+	if IsLeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// newYear returns the Julian Day Number of 1 Chaitra of `year`.
+func newYear(year int) int64 {
+	day := 22
+	if IsLeapYear(year) {
+		day = 21
+	}
+	return calendrical.JDN(time.Date(year+78, time.March, day, 0, 0, 0, 0, time.UTC))
+}
+
+// digits formats `n` with the Devanagari digits ० to ९.
+func digits(n int) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '०' + r - '0'
+		}
+		return r
+	}, strconv.Itoa(n))
+}
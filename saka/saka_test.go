@@ -0,0 +1,105 @@
+package saka_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex/saka"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFromTime(t *testing.T) {
+	cases := []struct {
+		t        time.Time
+		expected Date
+	}{
+		{date(1979, time.February, 11), Date{1900, Magha, 22}},
+		{date(2000, time.January, 1), Date{1921, Pausha, 11}},
+		{date(2024, time.March, 20), Date{1945, Phalguna, 30}},
+		{date(2024, time.March, 21), Date{1946, Chaitra, 1}},
+		{date(2024, time.September, 22), Date{1946, Bhadra, 31}},
+		{date(2024, time.September, 23), Date{1946, Ashvin, 1}},
+		{date(2024, time.December, 22), Date{1946, Pausha, 1}},
+		{date(2025, time.March, 21), Date{1946, Phalguna, 30}},
+		{date(2025, time.March, 22), Date{1947, Chaitra, 1}},
+		{time.Date(2025, time.March, 22, 23, 0, 0, 0, time.FixedZone("IST", 11*30*60)), Date{1947, Chaitra, 1}},
+	}
+
+	for _, c := range cases {
+		got := FromTime(c.t)
+		if got != c.expected {
+			t.Errorf("FromTime(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	for d := date(1900, time.January, 1); d.Year() <= 2100; d = d.AddDate(0, 0, 1) {
+		s := FromTime(d)
+		got, err := s.Time(time.UTC)
+		if err != nil || !got.Equal(d) {
+			t.Fatalf("%v.Time(UTC) == %v, %v, want %v", s, got, err, d)
+		}
+	}
+
+	for _, d := range []Date{
+		{1947, Chaitra, 31},
+		{1946, Ashvin, 31},
+		{1946, Month(13), 1},
+		{1946, Chaitra, 0},
+	} {
+		if got, err := d.Time(time.UTC); err == nil {
+			t.Errorf("%v.Time(UTC) == %v, want an error", d, got)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	cases := []struct {
+		year     int
+		expected bool
+	}{
+		{1922, true},
+		{1946, true},
+		{1947, false},
+		{2022, false},
+		{2322, true},
+	}
+
+	for _, c := range cases {
+		if got := IsLeapYear(c.year); got != c.expected {
+			t.Errorf("IsLeapYear(%v) == %v, want %v", c.year, got, c.expected)
+		}
+		sum := 0
+		for m := Chaitra; m <= Phalguna; m++ {
+			sum += DaysInMonth(c.year, m)
+		}
+		if sum != DaysInYear(c.year) {
+			t.Errorf("months of %v have %v days, want %v", c.year, sum, DaysInYear(c.year))
+		}
+	}
+}
+
+func TestDateString(t *testing.T) {
+	d := Date{1946, Chaitra, 1}
+	if got, expected := d.String(), "1 Chaitra 1946"; got != expected {
+		t.Errorf("String() == %v, want %v", got, expected)
+	}
+	if got, expected := d.Native(), "१ चैत्र १९४६"; got != expected {
+		t.Errorf("Native() == %v, want %v", got, expected)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	d := date(2024, time.March, 25)
+	year, month, day := Calendar.Date(d)
+	if y, m, dd := 1946, 1, 5; year != y || int(month) != m || day != dd {
+		t.Errorf("Calendar.Date(%v) == %v, %v, %v, want %v, %v, %v", d, year, int(month), day, y, m, dd)
+	}
+	if got := Calendar.Time(year, month, day, time.UTC); !got.Equal(d) {
+		t.Errorf("Calendar.Time(%v, %v, %v, UTC) == %v, want %v", year, int(month), day, got, d)
+	}
+}
@@ -0,0 +1,148 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// StubRule decides the direction a Schedule is generated in and what
+// happens to the odd period left over when the term is not a whole
+// number of regular periods.
+type StubRule int
+
+const (
+	// ShortFrontStub generates backward from the termination date and
+	// leaves a short first period.
+	ShortFrontStub StubRule = iota
+	// LongFrontStub generates backward from the termination date and
+	// merges the odd period into the first regular period.
+	LongFrontStub
+	// ShortBackStub generates forward from the effective date and leaves
+	// a short last period.
+	ShortBackStub
+	// LongBackStub generates forward from the effective date and merges
+	// the odd period into the last regular period.
+	LongBackStub
+)
+
+// Schedule describes a series of payment or coupon dates between an
+// effective date and a termination date.
+type Schedule struct {
+	Effective   time.Time
+	Termination time.Time
+
+	// Frequency is the number of regular periods per year and must
+	// divide 12. 0 makes a single period from Effective to Termination.
+	Frequency int
+
+	Stub StubRule
+
+	// IMM moves every date other than Effective and Termination to the
+	// IMM date (the third Wednesday) of its month.
+	IMM bool
+
+	// EndOfMonth rolls every date to the end of its month when the date
+	// generation starts from the last day of a month.
+	EndOfMonth bool
+
+	// Convention and Calendar are used to adjust the dates to business
+	// days.
+	Convention BusinessDayConvention
+	Calendar   HolidayCalendar
+}
+
+// Dates generates the schedule. It returns the unadjusted dates and the
+// same dates adjusted to business days. Both start with the effective
+// date and end with the termination date. The clock of Effective is
+// used for the generated dates.
+func (s Schedule) Dates() (unadjusted, adjusted []time.Time, err error) {
+	if daysBetweenDates(s.Effective, s.Termination) <= 0 {
+		return nil, nil, fmt.Errorf("timex: schedule termination %v is not after effective %v", s.Termination, s.Effective)
+	}
+	if s.Frequency < 0 || (s.Frequency > 0 && 12%s.Frequency != 0) {
+		return nil, nil, fmt.Errorf("timex: schedule frequency %d does not divide 12", s.Frequency)
+	}
+
+	if s.Frequency == 0 {
+		unadjusted = []time.Time{s.Effective, s.Termination}
+	} else {
+		switch s.Stub {
+		case ShortFrontStub, LongFrontStub:
+			unadjusted = s.backward()
+		case ShortBackStub, LongBackStub:
+			unadjusted = s.forward()
+		default:
+			return nil, nil, fmt.Errorf("timex: unknown schedule stub rule %d", s.Stub)
+		}
+	}
+
+	adjusted = make([]time.Time, len(unadjusted))
+	for i, d := range unadjusted {
+		adjusted[i] = Adjust(d, s.Convention, s.Calendar)
+	}
+	return unadjusted, adjusted, nil
+}
+
+// backward generates the dates rolling back from the termination date.
+func (s Schedule) backward() []time.Time {
+	months := 12 / s.Frequency
+	anchor := withClock(s.Termination, s.Effective)
+
+	dates := []time.Time{s.Termination}
+	for k := 1; ; k++ {
+		d := s.roll(anchor, -k*months)
+		if daysBetweenDates(s.Effective, d) <= 0 {
+			break
+		}
+		dates = append(dates, d)
+	}
+	hasStub := daysBetweenDates(s.Effective, s.roll(anchor, -(len(dates))*months)) != 0
+	if hasStub && s.Stub == LongFrontStub && len(dates) > 1 {
+		dates = dates[:len(dates)-1]
+	}
+	dates = append(dates, s.Effective)
+
+	for i, j := 0, len(dates)-1; i < j; i, j = i+1, j-1 {
+		dates[i], dates[j] = dates[j], dates[i]
+	}
+	return dates
+}
+
+// forward generates the dates rolling forward from the effective date.
+func (s Schedule) forward() []time.Time {
+	months := 12 / s.Frequency
+
+	dates := []time.Time{s.Effective}
+	for k := 1; ; k++ {
+		d := s.roll(s.Effective, k*months)
+		if daysBetweenDates(d, s.Termination) <= 0 {
+			break
+		}
+		dates = append(dates, d)
+	}
+	hasStub := daysBetweenDates(s.roll(s.Effective, len(dates)*months), s.Termination) != 0
+	if hasStub && s.Stub == LongBackStub && len(dates) > 1 {
+		dates = dates[:len(dates)-1]
+	}
+	return append(dates, s.Termination)
+}
+
+// roll moves `anchor` by `months`, applying the end of month and IMM
+// rules.
+func (s Schedule) roll(anchor time.Time, months int) time.Time {
+	d := addMonths(anchor, months)
+	if s.EndOfMonth && anchor.Day() == DaysInMonth(anchor.Year(), anchor.Month()) {
+		d = LastDayOfMonth(d)
+	}
+	if s.IMM {
+		d = ThirdWednesday(d)
+	}
+	return d
+}
+
+// withClock returns the date of `t` with the clock and location of `c`.
+func withClock(t, c time.Time) time.Time {
+	y, m, d := t.Date()
+	h, mi, s := c.Clock()
+	return time.Date(y, m, d, h, mi, s, c.Nanosecond(), c.Location())
+}
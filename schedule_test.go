@@ -0,0 +1,193 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestScheduleDates(t *testing.T) {
+	cases := []struct {
+		name       string
+		s          Schedule
+		unadjusted []time.Time
+		adjusted   []time.Time
+	}{
+		{
+			"regular semiannual",
+			Schedule{
+				Effective:   date(2015, time.January, 15),
+				Termination: date(2016, time.January, 15),
+				Frequency:   2,
+			},
+			[]time.Time{date(2015, time.January, 15), date(2015, time.July, 15), date(2016, time.January, 15)},
+			[]time.Time{date(2015, time.January, 15), date(2015, time.July, 15), date(2016, time.January, 15)},
+		},
+		{
+			"short front stub",
+			Schedule{
+				Effective:   date(2015, time.March, 1),
+				Termination: date(2016, time.January, 15),
+				Frequency:   2,
+			},
+			[]time.Time{date(2015, time.March, 1), date(2015, time.July, 15), date(2016, time.January, 15)},
+			nil,
+		},
+		{
+			"long front stub",
+			Schedule{
+				Effective:   date(2015, time.March, 1),
+				Termination: date(2016, time.January, 15),
+				Frequency:   2,
+				Stub:        LongFrontStub,
+			},
+			[]time.Time{date(2015, time.March, 1), date(2016, time.January, 15)},
+			nil,
+		},
+		{
+			"short back stub",
+			Schedule{
+				Effective:   date(2015, time.January, 15),
+				Termination: date(2015, time.November, 1),
+				Frequency:   4,
+				Stub:        ShortBackStub,
+			},
+			[]time.Time{
+				date(2015, time.January, 15), date(2015, time.April, 15), date(2015, time.July, 15),
+				date(2015, time.October, 15), date(2015, time.November, 1),
+			},
+			nil,
+		},
+		{
+			"long back stub",
+			Schedule{
+				Effective:   date(2015, time.January, 15),
+				Termination: date(2015, time.November, 1),
+				Frequency:   4,
+				Stub:        LongBackStub,
+			},
+			[]time.Time{
+				date(2015, time.January, 15), date(2015, time.April, 15), date(2015, time.July, 15),
+				date(2015, time.November, 1),
+			},
+			nil,
+		},
+		{
+			"end of month",
+			Schedule{
+				Effective:   date(2015, time.February, 28),
+				Termination: date(2015, time.August, 31),
+				Frequency:   12,
+				Stub:        ShortBackStub,
+				EndOfMonth:  true,
+			},
+			[]time.Time{
+				date(2015, time.February, 28), date(2015, time.March, 31), date(2015, time.April, 30),
+				date(2015, time.May, 31), date(2015, time.June, 30), date(2015, time.July, 31),
+				date(2015, time.August, 31),
+			},
+			nil,
+		},
+		{
+			"no end of month keeps the day",
+			Schedule{
+				Effective:   date(2015, time.January, 31),
+				Termination: date(2015, time.May, 31),
+				Frequency:   12,
+				Stub:        ShortBackStub,
+			},
+			[]time.Time{
+				date(2015, time.January, 31), date(2015, time.February, 28), date(2015, time.March, 31),
+				date(2015, time.April, 30), date(2015, time.May, 31),
+			},
+			nil,
+		},
+		{
+			"IMM dates",
+			Schedule{
+				Effective:   date(2015, time.March, 18),
+				Termination: date(2015, time.December, 16),
+				Frequency:   4,
+				IMM:         true,
+			},
+			[]time.Time{
+				date(2015, time.March, 18), date(2015, time.June, 17), date(2015, time.September, 16),
+				date(2015, time.December, 16),
+			},
+			nil,
+		},
+		{
+			"business day adjustment",
+			Schedule{
+				Effective:   date(2015, time.January, 31),
+				Termination: date(2015, time.October, 31),
+				Frequency:   4,
+				Convention:  ModifiedFollowing,
+				Calendar:    holidays2015,
+			},
+			[]time.Time{
+				date(2015, time.January, 31), date(2015, time.April, 30), date(2015, time.July, 31),
+				date(2015, time.October, 31),
+			},
+			[]time.Time{
+				date(2015, time.January, 30), date(2015, time.April, 30), date(2015, time.July, 31),
+				date(2015, time.October, 30),
+			},
+		},
+		{
+			"single period",
+			Schedule{
+				Effective:   date(2015, time.January, 15),
+				Termination: date(2015, time.March, 1),
+				Convention:  Following,
+			},
+			[]time.Time{date(2015, time.January, 15), date(2015, time.March, 1)},
+			[]time.Time{date(2015, time.January, 15), date(2015, time.March, 2)},
+		},
+	}
+
+	for _, c := range cases {
+		unadjusted, adjusted, err := c.s.Dates()
+		if err != nil {
+			t.Errorf("%s: Dates() returned error %v", c.name, err)
+			continue
+		}
+		if !equalTimes(unadjusted, c.unadjusted) {
+			t.Errorf("%s: Dates() unadjusted == %v, want %v", c.name, unadjusted, c.unadjusted)
+		}
+		if c.adjusted == nil {
+			c.adjusted = c.unadjusted
+		}
+		if !equalTimes(adjusted, c.adjusted) {
+			t.Errorf("%s: Dates() adjusted == %v, want %v", c.name, adjusted, c.adjusted)
+		}
+	}
+}
+
+func TestScheduleDatesErrors(t *testing.T) {
+	cases := []Schedule{
+		{Effective: date(2015, time.January, 15), Termination: date(2015, time.January, 15)},
+		{Effective: date(2015, time.January, 15), Termination: date(2014, time.January, 15)},
+		{Effective: date(2015, time.January, 15), Termination: date(2016, time.January, 15), Frequency: 5},
+		{Effective: date(2015, time.January, 15), Termination: date(2016, time.January, 15), Frequency: 2, Stub: StubRule(9)},
+	}
+
+	for _, s := range cases {
+		if _, _, err := s.Dates(); err == nil {
+			t.Errorf("Dates() for %+v returned no error", s)
+		}
+	}
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,305 @@
+// Package scheduler runs jobs in process at the occurrences of timex
+// schedules, such as the top of every hour or the last business day of
+// each month.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/justrudd/timex"
+)
+
+// OverlapPolicy decides what happens when a run of a job is due while
+// the previous run is still going or, when the concurrency limit is
+// reached, still waiting to start.
+type OverlapPolicy int
+
+const (
+	// Skip drops the new run.
+	Skip OverlapPolicy = iota
+	// Queue starts the new run when the previous one returns.
+	Queue
+	// Cancel cancels the context of the previous run, or drops it if it
+	// has not started, and starts the new run when it returns.
+	Cancel
+)
+
+// CatchUpPolicy decides what happens to the runs missed while the
+// scheduler was not running, was late or the clock jumped forward.
+type CatchUpPolicy int
+
+const (
+	// SkipMissed only runs the latest of the occurrences that are due.
+	SkipMissed CatchUpPolicy = iota
+	// RunMissed runs every occurrence that is due, oldest first.
+	RunMissed
+)
+
+// Job is a function run at the occurrences of a schedule.
+type Job struct {
+	// Name identifies the job and must be unique within a Scheduler.
+	Name string
+
+	// Next returns the occurrences of the schedule.
+	Next timex.NextFunc
+
+	// Run is called for each run with the occurrence it is for. The
+	// context is cancelled when the scheduler stops or, with the Cancel
+	// policy, when the next run is due.
+	Run func(ctx context.Context, at time.Time)
+
+	Overlap OverlapPolicy
+	CatchUp CatchUpPolicy
+
+	// Jitter delays each run by a random duration below it, to spread
+	// the load of jobs on the same schedule.
+	Jitter time.Duration
+
+	// After is the time occurrences are counted from. The zero value is
+	// the time the job is added; an earlier time, such as the last run
+	// before a restart, makes the occurrences since then missed runs.
+	After time.Time
+}
+
+// maxWait bounds how long the scheduler trusts a single timer, so that
+// it follows changes of the wall clock. See timex.AlignedTicker.
+const maxWait = time.Minute
+
+// Scheduler runs jobs. Jobs may be added and removed at any time; they
+// only run while Run is running.
+type Scheduler struct {
+	clock       timex.Clock
+	concurrency int
+
+	mu      sync.Mutex
+	jobs    []*job
+	wake    chan struct{}
+	running bool // Run has been called and has not returned
+}
+
+type job struct {
+	Job
+	due     time.Time // the next occurrence
+	runAt   time.Time // due plus jitter
+	running bool
+	cancel  context.CancelFunc
+	pending []time.Time // due runs waiting to start
+}
+
+// New returns a Scheduler that uses `clock` and runs at most
+// `concurrency` jobs at once, or any number when `concurrency` is 0.
+func New(clock timex.Clock, concurrency int) *Scheduler {
+	return &Scheduler{
+		clock:       clock,
+		concurrency: concurrency,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Add adds a job to the scheduler.
+func (s *Scheduler) Add(j Job) error {
+	if j.Name == "" {
+		return fmt.Errorf("scheduler: job has no name")
+	}
+	if j.Next == nil || j.Run == nil {
+		return fmt.Errorf("scheduler: job %q needs Next and Run", j.Name)
+	}
+	if j.Overlap < Skip || j.Overlap > Cancel {
+		return fmt.Errorf("scheduler: job %q has unknown overlap policy %d", j.Name, j.Overlap)
+	}
+	if j.CatchUp < SkipMissed || j.CatchUp > RunMissed {
+		return fmt.Errorf("scheduler: job %q has unknown catch up policy %d", j.Name, j.CatchUp)
+	}
+	if j.Jitter < 0 {
+		return fmt.Errorf("scheduler: job %q has negative jitter", j.Name)
+	}
+
+	after := j.After
+	if after.IsZero() {
+		after = s.clock.Now()
+	}
+	jb := &job{Job: j}
+	jb.schedule(j.Next(after))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.jobs {
+		if o.Name == j.Name {
+			return fmt.Errorf("scheduler: job %q already exists", j.Name)
+		}
+	}
+	s.jobs = append(s.jobs, jb)
+	s.signal()
+	return nil
+}
+
+// Remove removes the job named `name` and returns whether it existed.
+// A run that has started is not cancelled.
+func (s *Scheduler) Remove(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, j := range s.jobs {
+		if j.Name == name {
+			s.jobs = append(s.jobs[:i], s.jobs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// JobStatus is the state of a job in a Scheduler.
+type JobStatus struct {
+	Name    string
+	Next    time.Time // the next occurrence
+	Running bool
+	Pending int // due runs waiting to start
+}
+
+// Status returns the state of the jobs in the order they were added.
+func (s *Scheduler) Status() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := make([]JobStatus, len(s.jobs))
+	for i, j := range s.jobs {
+		status[i] = JobStatus{Name: j.Name, Next: j.due, Running: j.running, Pending: len(j.pending)}
+	}
+	return status
+}
+
+// Run runs the jobs until `ctx` is done, then waits for the runs that
+// have started to return and returns the error of `ctx`. Only one call
+// of Run may be running at a time; others return an error at once.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return fmt.Errorf("scheduler: Run is already running")
+	}
+	s.running = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+	}()
+
+	done := make(chan struct{})
+	running := 0
+
+	for {
+		now := s.clock.Now()
+		s.mu.Lock()
+		for _, j := range s.jobs {
+			j.collect(now)
+		}
+		for s.concurrency == 0 || running < s.concurrency {
+			j := s.nextToStart()
+			if j == nil {
+				break
+			}
+			s.start(ctx, j, done)
+			running++
+		}
+		wait := maxWait
+		for _, j := range s.jobs {
+			if d := j.runAt.Sub(now); d < wait {
+				wait = d
+			}
+		}
+		s.mu.Unlock()
+
+		timer := s.clock.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			for ; running > 0; running-- {
+				<-done
+			}
+			return ctx.Err()
+		case <-done:
+			running--
+		case <-s.wake:
+		case <-timer.C():
+		}
+		timer.Stop()
+	}
+}
+
+// nextToStart returns the job with the oldest run waiting to start.
+func (s *Scheduler) nextToStart() *job {
+	var next *job
+	for _, j := range s.jobs {
+		if j.running || len(j.pending) == 0 {
+			continue
+		}
+		if next == nil || j.pending[0].Before(next.pending[0]) {
+			next = j
+		}
+	}
+	return next
+}
+
+func (s *Scheduler) start(ctx context.Context, j *job, done chan<- struct{}) {
+	at := j.pending[0]
+	j.pending = j.pending[1:]
+	ctx, cancel := context.WithCancel(ctx)
+	j.running = true
+	j.cancel = cancel
+
+	go func() {
+		j.Run(ctx, at)
+		cancel()
+		s.mu.Lock()
+		j.running = false
+		s.mu.Unlock()
+		done <- struct{}{}
+	}()
+}
+
+func (s *Scheduler) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// collect moves the runs due at `now` to the pending runs according to
+// the policies of the job.
+func (j *job) collect(now time.Time) {
+	var due []time.Time
+	for !j.runAt.After(now) {
+		due = append(due, j.due)
+		j.schedule(j.Next(j.due))
+	}
+	if len(due) == 0 {
+		return
+	}
+	if j.CatchUp == SkipMissed {
+		due = due[len(due)-1:]
+	}
+
+	if j.running || len(j.pending) > 0 {
+		switch j.Overlap {
+		case Skip:
+			return
+		case Cancel:
+			if j.running {
+				j.cancel()
+			}
+			j.pending = nil
+		}
+	}
+	j.pending = append(j.pending, due...)
+}
+
+func (j *job) schedule(due time.Time) {
+	j.due = due
+	j.runAt = due
+	if j.Jitter > 0 {
+		j.runAt = due.Add(time.Duration(rand.Int63n(int64(j.Jitter))))
+	}
+}
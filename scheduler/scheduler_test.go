@@ -0,0 +1,299 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justrudd/timex"
+	. "github.com/justrudd/timex/scheduler"
+)
+
+var start = time.Date(2015, time.March, 8, 0, 0, 0, 0, time.UTC)
+
+// run starts `s` on a context that is cancelled by the returned
+// function, which waits for Run to return.
+func run(t *testing.T, s *Scheduler) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error)
+	go func() { errc <- s.Run(ctx) }()
+	return func() {
+		cancel()
+		if err := <-errc; err != context.Canceled {
+			t.Errorf("Run returned %v, want %v", err, context.Canceled)
+		}
+	}
+}
+
+// advance moves `fc` forward a minute at a time, waiting for the
+// scheduler to arm its timer before each step.
+func advance(fc *timex.FakeClock, minutes int) {
+	for i := 0; i < minutes; i++ {
+		fc.BlockUntil(1)
+		fc.Advance(time.Minute)
+	}
+	fc.BlockUntil(1)
+}
+
+// recorder returns a job function that sends the occurrence of each run
+// on a channel.
+func recorder() (func(context.Context, time.Time), chan time.Time) {
+	c := make(chan time.Time, 100)
+	return func(ctx context.Context, at time.Time) { c <- at }, c
+}
+
+func expectRuns(t *testing.T, c chan time.Time, expected ...time.Time) {
+	for _, want := range expected {
+		if got := <-c; !got.Equal(want) {
+			t.Errorf("run at %v, want %v", got, want)
+		}
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	fc := timex.NewFakeClock(start)
+	s := New(fc, 0)
+	f, runs := recorder()
+	// queued so that no run is skipped if the previous one has not yet
+	// returned
+	if err := s.Add(Job{Name: "hourly", Next: timex.Every(time.Hour), Run: f, Overlap: Queue}); err != nil {
+		t.Fatal(err)
+	}
+	stop := run(t, s)
+	defer stop()
+
+	for i := 1; i <= 3; i++ {
+		advance(fc, 60)
+		expectRuns(t, runs, start.Add(time.Duration(i)*time.Hour))
+	}
+	if status := s.Status(); len(status) != 1 || !status[0].Next.Equal(start.Add(4*time.Hour)) {
+		t.Errorf("Status() == %+v, want the next run at %v", status, start.Add(4*time.Hour))
+	}
+
+	if !s.Remove("hourly") {
+		t.Error("Remove returned false for an existing job")
+	}
+	if s.Remove("hourly") {
+		t.Error("Remove returned true for a removed job")
+	}
+	advance(fc, 60)
+	select {
+	case at := <-runs:
+		t.Errorf("removed job ran at %v", at)
+	default:
+	}
+}
+
+func TestSchedulerAdd(t *testing.T) {
+	s := New(timex.NewFakeClock(start), 0)
+	f := func(context.Context, time.Time) {}
+	next := timex.Every(time.Hour)
+
+	if err := s.Add(Job{Name: "a", Next: next, Run: f}); err != nil {
+		t.Errorf("Add returned error %v", err)
+	}
+
+	bad := []Job{
+		{Next: next, Run: f},
+		{Name: "b", Run: f},
+		{Name: "b", Next: next},
+		{Name: "b", Next: next, Run: f, Overlap: Cancel + 1},
+		{Name: "b", Next: next, Run: f, CatchUp: RunMissed + 1},
+		{Name: "b", Next: next, Run: f, Jitter: -time.Second},
+		{Name: "a", Next: next, Run: f},
+	}
+	for _, j := range bad {
+		if err := s.Add(j); err == nil {
+			t.Errorf("Add(%+v) returned no error", j)
+		}
+	}
+}
+
+// blockingJob returns a job function that records each run and blocks
+// until it is released or its context is done.
+func blockingJob() (f func(context.Context, time.Time), runs, cancelled chan time.Time, release chan struct{}) {
+	runs = make(chan time.Time, 100)
+	cancelled = make(chan time.Time, 100)
+	release = make(chan struct{})
+	f = func(ctx context.Context, at time.Time) {
+		runs <- at
+		select {
+		case <-release:
+		case <-ctx.Done():
+			cancelled <- at
+		}
+	}
+	return f, runs, cancelled, release
+}
+
+func TestSchedulerOverlap(t *testing.T) {
+	minute := func(n int) time.Time { return start.Add(time.Duration(n) * time.Minute) }
+
+	cases := []struct {
+		overlap   OverlapPolicy
+		runs      []time.Time
+		cancelled []time.Time
+	}{
+		{Skip, []time.Time{minute(1)}, nil},
+		{Queue, []time.Time{minute(1), minute(2), minute(3), minute(4)}, nil},
+		{Cancel, []time.Time{minute(1), minute(2), minute(3)}, []time.Time{minute(1), minute(2)}},
+	}
+
+	for _, c := range cases {
+		fc := timex.NewFakeClock(start)
+		s := New(fc, 0)
+		f, runs, cancelled, release := blockingJob()
+		s.Add(Job{Name: "job", Next: timex.Every(time.Minute), Run: f, Overlap: c.overlap})
+		stop := run(t, s)
+
+		advance(fc, 1)
+		expectRuns(t, runs, c.runs[0])
+		for i := 0; i < 2; i++ {
+			advance(fc, 1)
+			if c.overlap == Cancel {
+				expectRuns(t, cancelled, c.cancelled[i])
+				expectRuns(t, runs, c.runs[i+1])
+			}
+		}
+		switch c.overlap {
+		case Skip:
+			// the runs due while the first was going were dropped
+			if status := s.Status(); !status[0].Running || status[0].Pending != 0 {
+				t.Errorf("Status() == %+v, want one run and none waiting", status)
+			}
+		case Queue:
+			advance(fc, 1)
+			for range c.runs {
+				release <- struct{}{}
+			}
+			expectRuns(t, runs, c.runs[1:]...)
+		}
+		stop()
+	}
+}
+
+func TestSchedulerCatchUp(t *testing.T) {
+	hours := []time.Time{start.Add(-2 * time.Hour), start.Add(-time.Hour), start}
+
+	cases := []struct {
+		catchUp  CatchUpPolicy
+		expected []time.Time
+	}{
+		{SkipMissed, hours[2:]},
+		{RunMissed, hours},
+	}
+
+	for _, c := range cases {
+		fc := timex.NewFakeClock(start)
+		s := New(fc, 0)
+		f, runs := recorder()
+		s.Add(Job{
+			Name:    "hourly",
+			Next:    timex.Every(time.Hour),
+			Run:     f,
+			CatchUp: c.catchUp,
+			After:   start.Add(-3 * time.Hour),
+		})
+		stop := run(t, s)
+		expectRuns(t, runs, c.expected...)
+
+		advance(fc, 60)
+		expectRuns(t, runs, start.Add(time.Hour))
+		stop()
+	}
+}
+
+func TestSchedulerConcurrency(t *testing.T) {
+	fc := timex.NewFakeClock(start)
+	s := New(fc, 1)
+	f, runs, _, release := blockingJob()
+	s.Add(Job{Name: "a", Next: timex.Every(time.Hour), Run: f})
+	s.Add(Job{Name: "b", Next: timex.Every(time.Hour), Run: f})
+	stop := run(t, s)
+	defer stop()
+
+	advance(fc, 60)
+	expectRuns(t, runs, start.Add(time.Hour))
+	if status := s.Status(); !status[0].Running || status[1].Running || status[1].Pending != 1 {
+		t.Errorf("Status() == %+v, want the second job waiting for the first", status)
+	}
+	release <- struct{}{}
+	expectRuns(t, runs, start.Add(time.Hour))
+	release <- struct{}{}
+}
+
+func TestSchedulerJitter(t *testing.T) {
+	fc := timex.NewFakeClock(start)
+	s := New(fc, 0)
+	f, runs := recorder()
+	s.Add(Job{Name: "jittered", Next: timex.Every(time.Hour), Run: f, Jitter: 10 * time.Minute})
+	stop := run(t, s)
+	defer stop()
+
+	advance(fc, 59)
+	if status := s.Status(); !status[0].Next.Equal(start.Add(time.Hour)) {
+		t.Errorf("jittered run started at %v, before its occurrence", fc.Now())
+	}
+	advance(fc, 11)
+	expectRuns(t, runs, start.Add(time.Hour))
+}
+
+func TestSchedulerStop(t *testing.T) {
+	fc := timex.NewFakeClock(start)
+	s := New(fc, 0)
+	f, runs, cancelled, _ := blockingJob()
+	s.Add(Job{Name: "job", Next: timex.Every(time.Minute), Run: f})
+	stop := run(t, s)
+
+	advance(fc, 1)
+	expectRuns(t, runs, start.Add(time.Minute))
+	stop()
+	expectRuns(t, cancelled, start.Add(time.Minute))
+}
+
+func TestSchedulerOverlapWaiting(t *testing.T) {
+	minute := func(n int) time.Time { return start.Add(time.Duration(n) * time.Minute) }
+
+	cases := []struct {
+		overlap OverlapPolicy
+		pending int
+		first   time.Time
+	}{
+		{Skip, 1, minute(1)},
+		{Queue, 10, minute(1)},
+		{Cancel, 1, minute(10)},
+	}
+
+	for _, c := range cases {
+		fc := timex.NewFakeClock(start)
+		s := New(fc, 1)
+		slow, slowRuns, _, release := blockingJob()
+		f, runs := recorder()
+		s.Add(Job{Name: "slow", Next: timex.Every(time.Minute), Run: slow})
+		s.Add(Job{Name: "waiting", Next: timex.Every(time.Minute), Run: f, Overlap: c.overlap})
+		stop := run(t, s)
+
+		// the slow job holds the only slot while the other comes due
+		advance(fc, 10)
+		expectRuns(t, slowRuns, minute(1))
+		if status := s.Status(); status[1].Running || status[1].Pending != c.pending {
+			t.Errorf("overlap %d: Status() == %+v, want %d runs waiting", c.overlap, status, c.pending)
+		}
+		release <- struct{}{}
+		expectRuns(t, runs, c.first)
+		stop()
+	}
+}
+
+func TestSchedulerRunTwice(t *testing.T) {
+	fc := timex.NewFakeClock(start)
+	s := New(fc, 0)
+	stop := run(t, s)
+	defer stop()
+
+	// the first Run has started once its timer is waiting
+	fc.BlockUntil(1)
+	if err := s.Run(context.Background()); err == nil {
+		t.Error("second Run returned no error")
+	}
+}
@@ -0,0 +1,186 @@
+package timex
+
+import (
+	"math"
+	"time"
+)
+
+// The functions below compute the times of sunrise, sunset and solar
+// noon with the algorithm of the NOAA Solar Calculator, from Meeus's
+// Astronomical Algorithms. For latitudes between ±72° the times are
+// accurate to within a minute; nearer the poles, where the sun crosses
+// the horizon at a shallow angle, they are accurate to within ten.
+//
+// Each takes the civil date of `date` and returns times in its
+// location, and takes the latitude `lat` and longitude `lon` of the
+// observer in degrees, north and east positive. Sunrise and sunset are
+// when the upper edge of the sun, refracted by the atmosphere, is on the
+// horizon of a sea level observer. Events are those of the day around
+// the solar noon of the date, so at high latitudes a sunset can fall
+// after midnight, on the next date.
+
+const (
+	// sunriseZenith is the zenith angle of the center of the sun at
+	// sunrise and sunset: 90° plus 34' of refraction and its 16' radius.
+	sunriseZenith = 90.833
+	// civilTwilightZenith is the zenith angle of the center of the sun at
+	// the start of civil dawn and the end of civil dusk.
+	civilTwilightZenith = 96
+)
+
+// SolarNoon returns the time the sun crosses the meridian, when it is
+// highest in the sky. `lat` does not change the time and is accepted for
+// symmetry with Sunrise and Sunset.
+func SolarNoon(date time.Time, lat, lon float64) time.Time {
+	jd := julianDate(date)
+	noon := 720 - 4*lon - equationOfTime(julianCentury(jd-lon/360))
+	noon = 720 - 4*lon - equationOfTime(julianCentury(jd+noon/1440))
+	return fromJulianMinutes(jd, noon, date.Location())
+}
+
+// Sunrise returns the time of sunrise. `ok` is false when the sun does
+// not rise or set that day, in the polar night or the polar day.
+func Sunrise(date time.Time, lat, lon float64) (sunrise time.Time, ok bool) {
+	return sunEvent(date, lat, lon, sunriseZenith, true)
+}
+
+// Sunset returns the time of sunset. `ok` is false when the sun does not
+// rise or set that day, in the polar night or the polar day.
+func Sunset(date time.Time, lat, lon float64) (sunset time.Time, ok bool) {
+	return sunEvent(date, lat, lon, sunriseZenith, false)
+}
+
+// CivilTwilight returns the start of civil dawn and the end of civil
+// dusk, when the center of the sun is 6° below the horizon. Between
+// them there is light enough to work outdoors without artificial
+// light. `ok` is false when the sun does not go that far below the
+// horizon or does not rise that far above it.
+func CivilTwilight(date time.Time, lat, lon float64) (dawn, dusk time.Time, ok bool) {
+	dawn, ok = sunEvent(date, lat, lon, civilTwilightZenith, true)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	dusk, _ = sunEvent(date, lat, lon, civilTwilightZenith, false)
+	return dawn, dusk, true
+}
+
+// DaylightInterval returns the interval from sunrise to sunset. In the
+// polar day it is the whole date, from midnight to midnight, and in the
+// polar night it is empty, with `start` and `end` both at solar noon.
+func DaylightInterval(date time.Time, lat, lon float64) (start, end time.Time) {
+	if sunrise, ok := Sunrise(date, lat, lon); ok {
+		sunset, _ := Sunset(date, lat, lon)
+		return sunrise, sunset
+	}
+	noon := SolarNoon(date, lat, lon)
+	if solarElevation(date, lat, lon) < 0 {
+		return noon, noon
+	}
+	y, m, d := date.Date()
+	loc := date.Location()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc), time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+}
+
+// sunEvent returns the time the sun is at `zenith` degrees before noon
+// when `rising` and after it otherwise. The time is first found with the
+// sun's position at noon and then refined with its position at that
+// time.
+func sunEvent(date time.Time, lat, lon, zenith float64, rising bool) (time.Time, bool) {
+	jd := julianDate(date)
+	minutes := 720 - 4*lon
+	for i := 0; i < 2; i++ {
+		t := julianCentury(jd + minutes/1440)
+		ha, ok := hourAngle(lat, sunDeclination(t), zenith)
+		if !ok {
+			return time.Time{}, false
+		}
+		if !rising {
+			ha = -ha
+		}
+		minutes = 720 - 4*(lon+ha) - equationOfTime(t)
+	}
+	return fromJulianMinutes(jd, minutes, date.Location()), true
+}
+
+// solarElevation returns the elevation of the sun in degrees above the
+// horizon at solar noon, ignoring refraction.
+func solarElevation(date time.Time, lat, lon float64) float64 {
+	noon := SolarNoon(date, lat, lon)
+	day, frac := ToJulianDay(noon)
+	decl := sunDeclination(julianCentury(float64(day) + frac))
+	return 90 - math.Abs(lat-decl)
+}
+
+// julianDate returns the Julian Date of midnight UTC on the civil date
+// of `date`.
+func julianDate(date time.Time) float64 {
+	y, m, d := date.Date()
+	day, frac := ToJulianDay(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	return float64(day) + frac
+}
+
+// fromJulianMinutes returns the time `minutes` after the Julian Date
+// `jd` in `loc`, rounded to the second.
+func fromJulianMinutes(jd, minutes float64, loc *time.Location) time.Time {
+	return FromJulianDay(0, jd+minutes/1440).Round(time.Second).In(loc)
+}
+
+// julianCentury returns the Julian centuries since J2000.0 of the Julian
+// Date `jd`.
+func julianCentury(jd float64) float64 {
+	return (jd - 2451545) / 36525
+}
+
+// hourAngle returns the hour angle in degrees of the sun at `zenith`
+// degrees. `ok` is false when the sun does not reach it.
+func hourAngle(lat, decl, zenith float64) (float64, bool) {
+	latR, declR := lat*deg, decl*deg
+	cos := math.Cos(zenith*deg)/(math.Cos(latR)*math.Cos(declR)) - math.Tan(latR)*math.Tan(declR)
+	if cos < -1 || cos > 1 {
+		return 0, false
+	}
+	return math.Acos(cos) / deg, true
+}
+
+const deg = math.Pi / 180
+
+// sunGeometry returns the sun's geometric mean longitude and mean
+// anomaly in degrees and the eccentricity of the earth's orbit at `t`
+// Julian centuries since J2000.0.
+func sunGeometry(t float64) (l0, m, e float64) {
+	l0 = math.Mod(280.46646+t*(36000.76983+0.0003032*t), 360)
+	m = 357.52911 + t*(35999.05029-0.0001537*t)
+	e = 0.016708634 - t*(0.000042037+0.0000001267*t)
+	return l0, m, e
+}
+
+// obliquity returns the obliquity of the ecliptic in degrees, corrected
+// for nutation.
+func obliquity(t float64) float64 {
+	e0 := 23 + (26+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60)/60
+	return e0 + 0.00256*math.Cos((125.04-1934.136*t)*deg)
+}
+
+// sunDeclination returns the declination of the sun in degrees.
+func sunDeclination(t float64) float64 {
+	l0, m, _ := sunGeometry(t)
+	mR := m * deg
+	c := math.Sin(mR)*(1.914602-t*(0.004817+0.000014*t)) +
+		math.Sin(2*mR)*(0.019993-0.000101*t) +
+		math.Sin(3*mR)*0.000289
+	lambda := l0 + c - 0.00569 - 0.00478*math.Sin((125.04-1934.136*t)*deg)
+	return math.Asin(math.Sin(obliquity(t)*deg)*math.Sin(lambda*deg)) / deg
+}
+
+// equationOfTime returns the difference in minutes between apparent and
+// mean solar time.
+func equationOfTime(t float64) float64 {
+	l0, m, e := sunGeometry(t)
+	y := math.Tan(obliquity(t) * deg / 2)
+	y *= y
+	l0R, mR := l0*deg, m*deg
+	eqt := y*math.Sin(2*l0R) - 2*e*math.Sin(mR) +
+		4*e*y*math.Sin(mR)*math.Cos(2*l0R) -
+		0.5*y*y*math.Sin(4*l0R) - 1.25*e*e*math.Sin(2*mR)
+	return 4 * eqt / deg
+}
@@ -0,0 +1,146 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+// Published times are to the minute, so computed ones may be off by one.
+func within(got, expected time.Time, d time.Duration) bool {
+	diff := got.Sub(expected)
+	return diff >= -d && diff <= d
+}
+
+func TestSunriseSunset(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	sydney, _ := time.LoadLocation("Australia/Sydney")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	cases := []struct {
+		date     time.Time
+		lat, lon float64
+		sunrise  time.Time
+		noon     time.Time
+		sunset   time.Time
+	}{
+		{
+			time.Date(2024, time.June, 21, 15, 0, 0, 0, london), 51.5074, -0.1278,
+			time.Date(2024, time.June, 21, 4, 43, 0, 0, london),
+			time.Date(2024, time.June, 21, 13, 2, 0, 0, london),
+			time.Date(2024, time.June, 21, 21, 21, 0, 0, london),
+		},
+		{
+			time.Date(2024, time.December, 21, 0, 0, 0, 0, nyc), 40.7128, -74.0060,
+			time.Date(2024, time.December, 21, 7, 17, 0, 0, nyc),
+			time.Date(2024, time.December, 21, 11, 54, 0, 0, nyc),
+			time.Date(2024, time.December, 21, 16, 32, 0, 0, nyc),
+		},
+		{
+			time.Date(2024, time.December, 21, 0, 0, 0, 0, sydney), -33.8688, 151.2093,
+			time.Date(2024, time.December, 21, 5, 41, 0, 0, sydney),
+			time.Date(2024, time.December, 21, 12, 53, 0, 0, sydney),
+			time.Date(2024, time.December, 21, 20, 5, 0, 0, sydney),
+		},
+		// sunrise is on the previous day in UTC
+		{
+			time.Date(2024, time.June, 21, 0, 0, 0, 0, tokyo), 35.6762, 139.6503,
+			time.Date(2024, time.June, 21, 4, 26, 0, 0, tokyo),
+			time.Date(2024, time.June, 21, 11, 43, 0, 0, tokyo),
+			time.Date(2024, time.June, 21, 19, 0, 0, 0, tokyo),
+		},
+	}
+
+	for _, c := range cases {
+		got, ok := Sunrise(c.date, c.lat, c.lon)
+		if !ok || !within(got, c.sunrise, time.Minute) || got.Location() != c.date.Location() {
+			t.Errorf("Sunrise(%v, %v, %v) == %v, %v, want %v", c.date, c.lat, c.lon, got, ok, c.sunrise)
+		}
+		got = SolarNoon(c.date, c.lat, c.lon)
+		if !within(got, c.noon, time.Minute) {
+			t.Errorf("SolarNoon(%v, %v, %v) == %v, want %v", c.date, c.lat, c.lon, got, c.noon)
+		}
+		got, ok = Sunset(c.date, c.lat, c.lon)
+		if !ok || !within(got, c.sunset, time.Minute) {
+			t.Errorf("Sunset(%v, %v, %v) == %v, %v, want %v", c.date, c.lat, c.lon, got, ok, c.sunset)
+		}
+	}
+}
+
+func TestCivilTwilight(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	tromso, _ := time.LoadLocation("Europe/Oslo")
+
+	cases := []struct {
+		date     time.Time
+		lat, lon float64
+		ok       bool
+		dawn     time.Time
+		dusk     time.Time
+	}{
+		{
+			time.Date(2024, time.June, 21, 0, 0, 0, 0, london), 51.5074, -0.1278, true,
+			time.Date(2024, time.June, 21, 3, 55, 0, 0, london),
+			time.Date(2024, time.June, 21, 22, 9, 0, 0, london),
+		},
+		{
+			time.Date(2024, time.December, 21, 0, 0, 0, 0, nyc), 40.7128, -74.0060, true,
+			time.Date(2024, time.December, 21, 6, 46, 0, 0, nyc),
+			time.Date(2024, time.December, 21, 17, 3, 0, 0, nyc),
+		},
+		// twilight without a sunrise in the polar night
+		{
+			time.Date(2024, time.December, 21, 0, 0, 0, 0, tromso), 69.6492, 18.9553, true,
+			time.Date(2024, time.December, 21, 9, 32, 0, 0, tromso),
+			time.Date(2024, time.December, 21, 13, 53, 0, 0, tromso),
+		},
+		{time.Date(2024, time.June, 21, 0, 0, 0, 0, tromso), 69.6492, 18.9553, false, time.Time{}, time.Time{}},
+	}
+
+	for _, c := range cases {
+		dawn, dusk, ok := CivilTwilight(c.date, c.lat, c.lon)
+		if ok != c.ok || !within(dawn, c.dawn, time.Minute) || !within(dusk, c.dusk, time.Minute) {
+			t.Errorf("CivilTwilight(%v, %v, %v) == %v, %v, %v, want %v, %v, %v", c.date, c.lat, c.lon, dawn, dusk, ok, c.dawn, c.dusk, c.ok)
+		}
+	}
+}
+
+func TestDaylightInterval(t *testing.T) {
+	tromso, _ := time.LoadLocation("Europe/Oslo")
+	lat, lon := 69.6492, 18.9553
+
+	// polar day
+	summer := time.Date(2024, time.June, 21, 12, 0, 0, 0, tromso)
+	if _, ok := Sunrise(summer, lat, lon); ok {
+		t.Errorf("Sunrise(%v, %v, %v) should not be ok", summer, lat, lon)
+	}
+	if _, ok := Sunset(summer, lat, lon); ok {
+		t.Errorf("Sunset(%v, %v, %v) should not be ok", summer, lat, lon)
+	}
+	start, end := DaylightInterval(summer, lat, lon)
+	expectedStart := time.Date(2024, time.June, 21, 0, 0, 0, 0, tromso)
+	expectedEnd := time.Date(2024, time.June, 22, 0, 0, 0, 0, tromso)
+	if !start.Equal(expectedStart) || !end.Equal(expectedEnd) {
+		t.Errorf("DaylightInterval(%v, %v, %v) == %v, %v, want %v, %v", summer, lat, lon, start, end, expectedStart, expectedEnd)
+	}
+
+	// polar night
+	winter := time.Date(2024, time.December, 21, 12, 0, 0, 0, tromso)
+	if _, ok := Sunrise(winter, lat, lon); ok {
+		t.Errorf("Sunrise(%v, %v, %v) should not be ok", winter, lat, lon)
+	}
+	start, end = DaylightInterval(winter, lat, lon)
+	noon := SolarNoon(winter, lat, lon)
+	if !start.Equal(noon) || !end.Equal(noon) {
+		t.Errorf("DaylightInterval(%v, %v, %v) == %v, %v, want %v, %v", winter, lat, lon, start, end, noon, noon)
+	}
+
+	// the Arctic Circle still has a short day
+	start, end = DaylightInterval(winter, 66.5, lon)
+	sunrise, _ := Sunrise(winter, 66.5, lon)
+	sunset, _ := Sunset(winter, 66.5, lon)
+	if !start.Equal(sunrise) || !end.Equal(sunset) || !start.Before(end) {
+		t.Errorf("DaylightInterval(%v, 66.5, %v) == %v, %v, want %v, %v", winter, lon, start, end, sunrise, sunset)
+	}
+}
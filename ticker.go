@@ -0,0 +1,170 @@
+package timex
+
+import (
+	"sync"
+	"time"
+)
+
+// NextFunc returns the first occurrence of a recurring event strictly
+// after `t`.
+type NextFunc func(t time.Time) time.Time
+
+// Every returns a NextFunc for the multiples of `d` since the zero time,
+// as rounded to by time.Time.Truncate. Every(time.Hour) is the top of
+// every hour in UTC and in zones offset by whole hours.
+func Every(d time.Duration) NextFunc {
+	return func(t time.Time) time.Time {
+		return t.Truncate(d).Add(d)
+	}
+}
+
+// Recurring returns a NextFunc for occurrences found by applying `align`
+// to `t` and then, until the result is after `t`, to the times reached
+// by applying `step` again and again. `step` must move forward. Midnight
+// in New York is
+//
+//	Recurring(NextDay, func(t time.Time) time.Time {
+//		return BeginningOfDay(t.In(nyc))
+//	})
+//
+// and the first business day of each month is
+//
+//	Recurring(FirstDayOfNextMonth, func(t time.Time) time.Time {
+//		return Adjust(BeginningOfDay(FirstDayOfMonth(t)), Following, cal)
+//	})
+func Recurring(step, align Adjuster) NextFunc {
+	return func(t time.Time) time.Time {
+		for base := t; ; base = step(base) {
+			if nt := align(base); nt.After(t) {
+				return nt
+			}
+		}
+	}
+}
+
+// NextDay returns a new time.Time for the same clock on the next day.
+func NextDay(t time.Time) time.Time {
+	return t.AddDate(0, 0, 1)
+}
+
+// maxAlignedWait bounds how long an AlignedTicker trusts a single
+// timer. Timers measure elapsed time, so without a bound a change of
+// the wall clock would go unnoticed until the timer fires.
+const maxAlignedWait = time.Minute
+
+// AlignedTicker delivers the occurrences of a NextFunc on a channel,
+// like time.Ticker does for a fixed interval. Each occurrence is worked
+// out from the wall clock when the previous one fires, so ticks stay
+// on their calendar times across DST changes, and the wall clock is
+// checked at least once a minute, so they follow it when it is set
+// forward or back. Occurrences missed while the clock jumped forward
+// are skipped and only the latest of them is sent, and ticks the reader
+// is too slow to receive are dropped.
+type AlignedTicker struct {
+	C <-chan time.Time // the occurrences, as returned by the NextFunc
+
+	clock Clock
+	c     chan<- time.Time
+
+	// mu guards the channels of the running goroutine, which are
+	// replaced when a stopped ticker is reset.
+	mu      sync.Mutex
+	reset   chan NextFunc
+	stop    chan struct{}
+	stopped bool
+}
+
+// NewAlignedTicker returns an AlignedTicker for the occurrences of
+// `next` on `clock`. Stop the ticker to release its resources.
+func NewAlignedTicker(clock Clock, next NextFunc) *AlignedTicker {
+	c := make(chan time.Time, 1)
+	at := &AlignedTicker{
+		C:     c,
+		clock: clock,
+		c:     c,
+		reset: make(chan NextFunc),
+		stop:  make(chan struct{}),
+	}
+	go at.run(next, at.reset, at.stop)
+	return at
+}
+
+// Reset stops the ticker and restarts it with the occurrences of
+// `next`. Like time.Ticker.Reset, it also restarts a stopped ticker.
+func (at *AlignedTicker) Reset(next NextFunc) {
+	at.mu.Lock()
+	if at.stopped {
+		at.stopped = false
+		at.reset = make(chan NextFunc)
+		at.stop = make(chan struct{})
+		go at.run(next, at.reset, at.stop)
+		at.mu.Unlock()
+		return
+	}
+	reset, stop := at.reset, at.stop
+	at.mu.Unlock()
+
+	select {
+	case reset <- next:
+	case <-stop:
+	}
+}
+
+// Stop turns off the ticker. No more ticks are sent after Stop returns.
+// Like time.Ticker, Stop does not close the channel.
+func (at *AlignedTicker) Stop() {
+	at.mu.Lock()
+	defer at.mu.Unlock()
+	if !at.stopped {
+		at.stopped = true
+		close(at.stop)
+	}
+}
+
+func (at *AlignedTicker) run(next NextFunc, reset <-chan NextFunc, stop <-chan struct{}) {
+	due := next(at.clock.Now())
+	for {
+		wait := due.Sub(at.clock.Now())
+		if wait > maxAlignedWait {
+			wait = maxAlignedWait
+		}
+		timer := at.clock.NewTimer(wait)
+
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case next = <-reset:
+			timer.Stop()
+			due = next(at.clock.Now())
+			continue
+		case <-timer.C():
+		}
+
+		now := at.clock.Now()
+		if now.Before(due) {
+			// the wait was cut short or the clock was set back
+			due = next(now)
+			continue
+		}
+
+		// skip the occurrences missed while the clock jumped forward
+		for nt := next(due); !nt.After(now); nt = next(nt) {
+			due = nt
+		}
+
+		at.mu.Lock()
+		select {
+		case <-stop:
+			at.mu.Unlock()
+			return
+		default:
+		}
+		select {
+		case at.c <- due:
+		default:
+		}
+		at.mu.Unlock()
+		due = next(now)
+	}
+}
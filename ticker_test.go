@@ -0,0 +1,136 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+// runTicker advances `fc` a minute at a time until `until`, waiting for
+// the ticker to arm its timer before each step, and returns the ticks
+// received on the way.
+func runTicker(fc *FakeClock, at *AlignedTicker, until time.Time) []time.Time {
+	var ticks []time.Time
+	for fc.Now().Before(until) {
+		fc.BlockUntil(1)
+		fc.Advance(time.Minute)
+		fc.BlockUntil(1)
+		select {
+		case tick := <-at.C:
+			ticks = append(ticks, tick)
+		default:
+		}
+	}
+	return ticks
+}
+
+func TestRecurring(t *testing.T) {
+	midnightNYC := Recurring(NextDay, func(t time.Time) time.Time {
+		return BeginningOfDay(t.In(nyc))
+	})
+	firstBusinessDay := Recurring(FirstDayOfNextMonth, func(t time.Time) time.Time {
+		return Adjust(BeginningOfDay(FirstDayOfMonth(t)), Following, holidays2015)
+	})
+
+	cases := []struct {
+		next     NextFunc
+		t        time.Time
+		expected time.Time
+	}{
+		{midnightNYC, time.Date(2015, time.March, 7, 12, 0, 0, 0, nyc), time.Date(2015, time.March, 8, 0, 0, 0, 0, nyc)},
+		{midnightNYC, time.Date(2015, time.March, 8, 0, 0, 0, 0, nyc), time.Date(2015, time.March, 9, 0, 0, 0, 0, nyc)},
+		{midnightNYC, time.Date(2015, time.March, 8, 4, 59, 0, 0, utc), time.Date(2015, time.March, 9, 0, 0, 0, 0, nyc)},
+		{firstBusinessDay, date(2014, time.December, 15), date(2015, time.January, 2)},
+		{firstBusinessDay, date(2015, time.January, 2), date(2015, time.February, 2)},
+		{firstBusinessDay, date(2015, time.April, 30), date(2015, time.May, 1)},
+		{Every(time.Hour), time.Date(2015, time.March, 8, 1, 30, 0, 0, utc), time.Date(2015, time.March, 8, 2, 0, 0, 0, utc)},
+		{Every(time.Hour), time.Date(2015, time.March, 8, 2, 0, 0, 0, utc), time.Date(2015, time.March, 8, 3, 0, 0, 0, utc)},
+	}
+
+	for _, c := range cases {
+		got := c.next(c.t)
+		if !got.Equal(c.expected) {
+			t.Errorf("next(%v) == %v, want %v", c.t, got, c.expected)
+		}
+	}
+}
+
+func TestAlignedTickerDST(t *testing.T) {
+	fc := NewFakeClock(time.Date(2015, time.March, 7, 12, 0, 0, 0, nyc))
+	at := NewAlignedTicker(fc, Recurring(NextDay, func(t time.Time) time.Time {
+		return BeginningOfDay(t.In(nyc))
+	}))
+	defer at.Stop()
+
+	ticks := runTicker(fc, at, time.Date(2015, time.March, 10, 12, 0, 0, 0, nyc))
+	expected := []time.Time{
+		time.Date(2015, time.March, 8, 0, 0, 0, 0, nyc),
+		time.Date(2015, time.March, 9, 0, 0, 0, 0, nyc),
+		time.Date(2015, time.March, 10, 0, 0, 0, 0, nyc),
+	}
+	if !equalTimes(ticks, expected) {
+		t.Errorf("ticks == %v, want %v", ticks, expected)
+	}
+}
+
+func TestAlignedTickerClockJumps(t *testing.T) {
+	start := time.Date(2015, time.March, 8, 0, 30, 0, 0, utc)
+	fc := NewFakeClock(start)
+	at := NewAlignedTicker(fc, Every(time.Hour))
+	defer at.Stop()
+
+	// jumping forward several periods fires only the latest missed
+	// occurrence, within a minute
+	fc.BlockUntil(1)
+	fc.Set(start.Add(5 * time.Hour))
+	ticks := runTicker(fc, at, start.Add(6*time.Hour))
+	if expected := []time.Time{start.Add(270 * time.Minute), start.Add(330 * time.Minute)}; !equalTimes(ticks, expected) {
+		t.Errorf("ticks after jump forward == %v, want %v", ticks, expected)
+	}
+
+	// and so does a jump of less than a period that passes one
+	fc.BlockUntil(1)
+	fc.Set(start.Add(6*time.Hour + 45*time.Minute))
+	ticks = runTicker(fc, at, start.Add(7*time.Hour))
+	if expected := []time.Time{start.Add(390 * time.Minute)}; !equalTimes(ticks, expected) {
+		t.Errorf("ticks after short jump forward == %v, want %v", ticks, expected)
+	}
+
+	// jumping back repeats the occurrences
+	fc.Set(start)
+	ticks = runTicker(fc, at, start.Add(90*time.Minute))
+	if expected := []time.Time{start.Add(30 * time.Minute), start.Add(90 * time.Minute)}; !equalTimes(ticks, expected) {
+		t.Errorf("ticks after jump back == %v, want %v", ticks, expected)
+	}
+}
+
+func TestAlignedTickerResetStop(t *testing.T) {
+	start := time.Date(2015, time.March, 8, 0, 0, 0, 0, utc)
+	fc := NewFakeClock(start)
+	at := NewAlignedTicker(fc, Every(time.Hour))
+
+	at.Reset(Every(15 * time.Minute))
+	ticks := runTicker(fc, at, start.Add(30*time.Minute))
+	if expected := []time.Time{start.Add(15 * time.Minute), start.Add(30 * time.Minute)}; !equalTimes(ticks, expected) {
+		t.Errorf("ticks after Reset == %v, want %v", ticks, expected)
+	}
+
+	at.Stop()
+	at.Stop()
+	fc.Advance(time.Hour)
+	select {
+	case tick := <-at.C:
+		t.Errorf("stopped ticker sent %v", tick)
+	default:
+	}
+
+	// like time.Ticker, Reset restarts a stopped ticker
+	at.Reset(Every(time.Minute))
+	defer at.Stop()
+	now := fc.Now()
+	ticks = runTicker(fc, at, now.Add(2*time.Minute))
+	if expected := []time.Time{now.Add(time.Minute), now.Add(2 * time.Minute)}; !equalTimes(ticks, expected) {
+		t.Errorf("ticks after Reset of a stopped ticker == %v, want %v", ticks, expected)
+	}
+}
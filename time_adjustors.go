@@ -9,6 +9,13 @@ func BeginningOfDay(t time.Time) time.Time {
 	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
 }
 
+// BeginningOfWeek returns a new time.Time at the beginning of the first
+// day of the week containing `t`, where weeks begin on `weekStart`. The
+// timezone is not modified.
+func BeginningOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return BeginningOfDay(FirstDayOfWeek(t, weekStart))
+}
+
 // EndOfDay returns a new time.Time with the current date but at the
 // end of the day with 1 second remaining in the day. The timezone
 // is not modified.
@@ -19,3 +26,10 @@ func EndOfDay(t time.Time) time.Time {
 
 	return nt
 }
+
+// EndOfWeek returns a new time.Time at the end of the last day of the
+// week containing `t`, where weeks begin on `weekStart`. Like EndOfDay,
+// there is 1 second remaining in the week. The timezone is not modified.
+func EndOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return EndOfDay(LastDayOfWeek(t, weekStart))
+}
@@ -35,6 +35,38 @@ func TestBeginningOfDay(t *testing.T) {
 	}
 }
 
+func TestBeginningOfWeek(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, utc),
+			time.Sunday,
+			time.Date(2015, time.June, 28, 0, 0, 0, 0, utc),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, utc),
+			time.Monday,
+			time.Date(2015, time.June, 29, 0, 0, 0, 0, utc),
+		},
+		// the week starts before daylight saving time ends
+		{
+			time.Date(2015, time.November, 3, 15, 41, 0, 0, nyc),
+			time.Saturday,
+			time.Date(2015, time.October, 31, 0, 0, 0, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := BeginningOfWeek(c.t, c.weekStart)
+		if got != c.expected {
+			t.Errorf("BeginningOfWeek(%v, %s) == %v, want %v", c.t, c.weekStart, got, c.expected)
+		}
+	}
+}
+
 func TestEndOfDay(t *testing.T) {
 	// we use different time zones to ensure that they don't get messed with
 	tz1, _ := time.LoadLocation("UTC")
@@ -62,3 +94,34 @@ func TestEndOfDay(t *testing.T) {
 		t.Errorf("EndOfDay(%v) == %v, wanted %v", t3, g3, et3)
 	}
 }
+
+func TestEndOfWeek(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		expected  time.Time
+	}{
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, utc),
+			time.Sunday,
+			time.Date(2015, time.July, 4, 23, 59, 59, 0, utc),
+		},
+		{
+			time.Date(2015, time.July, 1, 15, 41, 0, 0, utc),
+			time.Monday,
+			time.Date(2015, time.July, 5, 23, 59, 59, 0, utc),
+		},
+		{
+			time.Date(2015, time.December, 31, 15, 41, 0, 0, nyc),
+			time.Monday,
+			time.Date(2016, time.January, 3, 23, 59, 59, 0, nyc),
+		},
+	}
+
+	for _, c := range cases {
+		got := EndOfWeek(c.t, c.weekStart)
+		if got != c.expected {
+			t.Errorf("EndOfWeek(%v, %s) == %v, want %v", c.t, c.weekStart, got, c.expected)
+		}
+	}
+}
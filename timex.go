@@ -22,10 +22,15 @@ var (
 
 // DaysInMonth returns the number of days in the month for the year.
 func DaysInMonth(y int, m time.Month) int {
-	if m == time.February && IsLeapYear(y) {
-		return 29
+	return Gregorian{}.DaysInMonth(y, m)
+}
+
+// DaysInYear returns the number of days in the year.
+func DaysInYear(y int) int {
+	if IsLeapYear(y) {
+		return 366
 	}
-	return daysInMonth[m]
+	return 365
 }
 
 // DaysBetweenWeekdays returns the number of days between two
@@ -45,7 +50,7 @@ func DaysBetweenWeekdays(w1, w2 time.Weekday) (dist int) {
 
 // IsLeapYear returns whether the year is a leap year
 func IsLeapYear(year int) bool {
-	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+	return Gregorian{}.IsLeapYear(year)
 }
 
 // NextBusinessWeekday returns the next business weekday after the
@@ -66,13 +71,7 @@ func NextBusinessWeekday(w time.Weekday) time.Weekday {
 // NextMonth returns the next month after the current month. It will wrap
 // from December to January.
 func NextMonth(m time.Month) time.Month {
-	var nm time.Month
-	switch m {
-	case time.December:
-		nm = time.January
-	default:
-		nm = m + 1
-	}
+	_, nm := NextMonthIn(Gregorian{}, 0, m)
 	return nm
 }
 
@@ -129,3 +128,38 @@ func PrevWeekday(w time.Weekday) time.Weekday {
 	}
 	return pw
 }
+
+// daysBetweenDates returns the number of calendar days from the date of
+// `a` to the date of `b`. The clocks and locations are ignored so days
+// that are shorter or longer because of daylight saving time still
+// count as one day. The days are counted from Unix time rather than a
+// time.Duration, which only reaches about 292 years.
+func daysBetweenDates(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	da := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	db := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int((db.Unix() - da.Unix()) / secondsPerDay)
+}
+
+// addMonths returns `t` moved by `n` months. Unlike t.AddDate, the day
+// is clamped to the end of the target month instead of overflowing into
+// the next, so January 31st plus one month is the last day of February.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+
+	mm := int(m) - 1 + n
+	y += mm / 12
+	mm %= 12
+	if mm < 0 {
+		mm += 12
+		y--
+	}
+	m = time.Month(mm + 1)
+	if dim := DaysInMonth(y, m); d > dim {
+		d = dim
+	}
+
+	return time.Date(y, m, d, h, mi, s, t.Nanosecond(), t.Location())
+}
@@ -53,6 +53,25 @@ func TestDaysInMonth(t *testing.T) {
 	}
 }
 
+func TestDaysInYear(t *testing.T) {
+	cases := []struct {
+		year     int
+		expected int
+	}{
+		{nonLeapYear, 365},
+		{leapYear, 366},
+		{1900, 365},
+		{2000, 366},
+	}
+
+	for _, c := range cases {
+		got := DaysInYear(c.year)
+		if got != c.expected {
+			t.Errorf("DaysInYear(%d) == %d, want %d", c.year, got, c.expected)
+		}
+	}
+}
+
 func TestDaysBetweenWeekdays(t *testing.T) {
 	cases := []struct {
 		w1, w2   time.Weekday
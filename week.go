@@ -0,0 +1,78 @@
+package timex
+
+import "time"
+
+// EachWeek calls `fn` with the first day of every week that overlaps
+// the dates from `start` through `end`, where weeks begin on
+// `weekStart`. The first call may be for a day before `start`. The clock
+// of `start` is kept. Iteration stops early if `fn` returns false.
+func EachWeek(start, end time.Time, weekStart time.Weekday, fn func(first time.Time) bool) {
+	for first := FirstDayOfWeek(start, weekStart); daysBetweenDates(first, end) >= 0; first = first.AddDate(0, 0, 7) {
+		if !fn(first) {
+			return
+		}
+	}
+}
+
+// IsLastWeekdayInMonth returns whether `t` is the last of its weekday in
+// its month, such as the last Friday.
+func IsLastWeekdayInMonth(t time.Time) bool {
+	_, fromEnd := WeekdayOccurrence(t)
+	return fromEnd == -1
+}
+
+// WeekOfMonth returns the week of the month that `t` falls in, where
+// weeks begin on `weekStart`. The week containing the first day of the
+// month is week 1, so a month spans 4 to 6 weeks.
+func WeekOfMonth(t time.Time, weekStart time.Weekday) int {
+	offset := DaysBetweenWeekdays(weekStart, FirstDayOfMonth(t).Weekday())
+	return (t.Day()-1+offset)/7 + 1
+}
+
+// WeekOfYear returns the year and week number that `t` falls in, where
+// weeks begin on `weekStart` and the first week of a year is the first
+// week with at least `minDays` days in that year. Days at the start or
+// end of a year can belong to a week of the neighbouring year.
+//
+// `WeekOfYear(t, time.Monday, 4)` is the ISO 8601 week and matches
+// t.ISOWeek(). `WeekOfYear(t, time.Sunday, 1)` is the common US
+// numbering where the week containing January 1st is week 1.
+func WeekOfYear(t time.Time, weekStart time.Weekday, minDays int) (year, week int) {
+	year = t.Year()
+	first := firstWeekOfYear(year, weekStart, minDays)
+	if daysBetweenDates(first, t) < 0 {
+		year--
+		first = firstWeekOfYear(year, weekStart, minDays)
+	} else if next := firstWeekOfYear(year+1, weekStart, minDays); daysBetweenDates(next, t) >= 0 {
+		year++
+		first = next
+	}
+	return year, daysBetweenDates(first, t)/7 + 1
+}
+
+// WeekOfYear returns the year and week number that `t` falls in using
+// the week conventions of the locale.
+func (l *Locale) WeekOfYear(t time.Time) (year, week int) {
+	return WeekOfYear(t, l.FirstDayOfWeek, l.MinDaysInFirstWeek)
+}
+
+// WeekdayOccurrence returns which occurrence of its weekday in its month
+// `t` is, counted from the start of the month and, as a negative number,
+// from the end. The second Tuesday of a month with four Tuesdays is
+// (2, -3). It is the inverse of NthDayOfWeek.
+func WeekdayOccurrence(t time.Time) (n, fromEnd int) {
+	d := t.Day()
+	n = (d-1)/7 + 1
+	fromEnd = -((DaysInMonth(t.Year(), t.Month())-d)/7 + 1)
+	return n, fromEnd
+}
+
+// firstWeekOfYear returns the first day of week 1 of `year`.
+func firstWeekOfYear(year int, weekStart time.Weekday, minDays int) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	first := FirstDayOfWeek(jan1, weekStart)
+	if 7-daysBetweenDates(first, jan1) < minDays {
+		first = first.AddDate(0, 0, 7)
+	}
+	return first
+}
@@ -0,0 +1,170 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/justrudd/timex"
+)
+
+func TestEachWeek(t *testing.T) {
+	start := time.Date(2015, time.July, 1, 9, 0, 0, 0, utc)
+	end := time.Date(2015, time.July, 31, 9, 0, 0, 0, utc)
+
+	var got []time.Time
+	EachWeek(start, end, time.Monday, func(first time.Time) bool {
+		got = append(got, first)
+		return true
+	})
+
+	expected := []time.Time{
+		time.Date(2015, time.June, 29, 9, 0, 0, 0, utc),
+		time.Date(2015, time.July, 6, 9, 0, 0, 0, utc),
+		time.Date(2015, time.July, 13, 9, 0, 0, 0, utc),
+		time.Date(2015, time.July, 20, 9, 0, 0, 0, utc),
+		time.Date(2015, time.July, 27, 9, 0, 0, 0, utc),
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("EachWeek(%v, %v, Monday) visited %v, want %v", start, end, got, expected)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("EachWeek(%v, %v, Monday)[%d] == %v, want %v", start, end, i, got[i], expected[i])
+		}
+	}
+
+	n := 0
+	EachWeek(start, end, time.Sunday, func(time.Time) bool {
+		n++
+		return n < 2
+	})
+	if n != 2 {
+		t.Errorf("EachWeek did not stop when fn returned false, called %d times", n)
+	}
+}
+
+func TestWeekOfMonth(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		expected  int
+	}{
+		// July 2015 starts on a Wednesday
+		{time.Date(2015, time.July, 1, 0, 0, 0, 0, utc), time.Sunday, 1},
+		{time.Date(2015, time.July, 4, 0, 0, 0, 0, utc), time.Sunday, 1},
+		{time.Date(2015, time.July, 5, 0, 0, 0, 0, utc), time.Sunday, 2},
+		{time.Date(2015, time.July, 5, 0, 0, 0, 0, utc), time.Monday, 1},
+		{time.Date(2015, time.July, 6, 0, 0, 0, 0, utc), time.Monday, 2},
+		{time.Date(2015, time.July, 31, 0, 0, 0, 0, utc), time.Sunday, 5},
+		// August 2015 starts on a Saturday and spans 6 Sunday based weeks
+		{time.Date(2015, time.August, 31, 0, 0, 0, 0, utc), time.Sunday, 6},
+		{time.Date(2015, time.August, 31, 0, 0, 0, 0, utc), time.Saturday, 5},
+		// February 2015 starts on a Sunday and spans exactly 4 weeks
+		{time.Date(2015, time.February, 28, 0, 0, 0, 0, utc), time.Sunday, 4},
+	}
+
+	for _, c := range cases {
+		got := WeekOfMonth(c.t, c.weekStart)
+		if got != c.expected {
+			t.Errorf("WeekOfMonth(%v, %s) == %d, want %d", c.t, c.weekStart, got, c.expected)
+		}
+	}
+}
+
+func TestWeekOfYear(t *testing.T) {
+	cases := []struct {
+		t         time.Time
+		weekStart time.Weekday
+		minDays   int
+		year      int
+		week      int
+	}{
+		// US numbering, the week with January 1st is week 1
+		{time.Date(2015, time.January, 1, 0, 0, 0, 0, utc), time.Sunday, 1, 2015, 1},
+		{time.Date(2015, time.January, 4, 0, 0, 0, 0, utc), time.Sunday, 1, 2015, 2},
+		{time.Date(2014, time.December, 28, 0, 0, 0, 0, utc), time.Sunday, 1, 2015, 1},
+		{time.Date(2016, time.December, 31, 0, 0, 0, 0, utc), time.Sunday, 1, 2016, 53},
+
+		// ISO 8601
+		{time.Date(2016, time.January, 1, 0, 0, 0, 0, utc), time.Monday, 4, 2015, 53},
+		{time.Date(2016, time.January, 4, 0, 0, 0, 0, utc), time.Monday, 4, 2016, 1},
+		{time.Date(2014, time.December, 29, 0, 0, 0, 0, utc), time.Monday, 4, 2015, 1},
+
+		// Middle Eastern weeks start on Saturday
+		{time.Date(2016, time.January, 1, 0, 0, 0, 0, utc), time.Saturday, 1, 2016, 1},
+		{time.Date(2016, time.January, 2, 0, 0, 0, 0, utc), time.Saturday, 1, 2016, 2},
+	}
+
+	for _, c := range cases {
+		year, week := WeekOfYear(c.t, c.weekStart, c.minDays)
+		if year != c.year || week != c.week {
+			t.Errorf("WeekOfYear(%v, %s, %d) == %d, %d, want %d, %d", c.t, c.weekStart, c.minDays, year, week, c.year, c.week)
+		}
+	}
+
+	// Monday weeks with 4 days in the first week must agree with ISOWeek
+	for d := time.Date(2000, time.January, 1, 12, 0, 0, 0, utc); d.Year() < 2030; d = d.AddDate(0, 0, 1) {
+		year, week := WeekOfYear(d, time.Monday, 4)
+		iy, iw := d.ISOWeek()
+		if year != iy || week != iw {
+			t.Fatalf("WeekOfYear(%v, Monday, 4) == %d, %d, want ISOWeek %d, %d", d, year, week, iy, iw)
+		}
+	}
+}
+
+func TestLocaleWeekOfYear(t *testing.T) {
+	d := time.Date(2016, time.January, 1, 0, 0, 0, 0, utc)
+
+	cases := []struct {
+		l    *Locale
+		year int
+		week int
+	}{
+		{EnglishUS, 2016, 1},
+		{German, 2015, 53},
+	}
+
+	for _, c := range cases {
+		year, week := c.l.WeekOfYear(d)
+		if year != c.year || week != c.week {
+			t.Errorf("%s.WeekOfYear(%v) == %d, %d, want %d, %d", c.l.Name, d, year, week, c.year, c.week)
+		}
+	}
+}
+
+func TestWeekdayOccurrence(t *testing.T) {
+	cases := []struct {
+		t       time.Time
+		n       int
+		fromEnd int
+		last    bool
+	}{
+		// Tuesdays of March 2015 are the 3rd, 10th, 17th, 24th and 31st
+		{date(2015, time.March, 3), 1, -5, false},
+		{date(2015, time.March, 17), 3, -3, false},
+		{date(2015, time.March, 31), 5, -1, true},
+		// Wednesdays of February 2015 are the 4th, 11th, 18th and 25th
+		{date(2015, time.February, 11), 2, -3, false},
+		{date(2015, time.February, 25), 4, -1, true},
+		// February 29th, 2016 is the fifth Monday
+		{date(2016, time.February, 29), 5, -1, true},
+		{date(2016, time.February, 22), 4, -2, false},
+		{time.Date(2015, time.November, 26, 23, 0, 0, 0, nyc), 4, -1, true},
+	}
+
+	for _, c := range cases {
+		n, fromEnd := WeekdayOccurrence(c.t)
+		if n != c.n || fromEnd != c.fromEnd {
+			t.Errorf("WeekdayOccurrence(%v) == (%d, %d), want (%d, %d)", c.t, n, fromEnd, c.n, c.fromEnd)
+		}
+		if got := NthDayOfWeek(c.t, c.t.Weekday(), n); got != c.t {
+			t.Errorf("NthDayOfWeek(%v, %v, %d) == %v, want %v", c.t, c.t.Weekday(), n, got, c.t)
+		}
+		if got := NthDayOfWeek(c.t, c.t.Weekday(), fromEnd); got != c.t {
+			t.Errorf("NthDayOfWeek(%v, %v, %d) == %v, want %v", c.t, c.t.Weekday(), fromEnd, got, c.t)
+		}
+		if got := IsLastWeekdayInMonth(c.t); got != c.last {
+			t.Errorf("IsLastWeekdayInMonth(%v) == %v, want %v", c.t, got, c.last)
+		}
+	}
+}